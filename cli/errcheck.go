@@ -19,6 +19,23 @@ import (
 	"os"
 )
 
+// errorExitHooks are called before quitting due to an error.
+var errorExitHooks []func()
+
+// OnErrorExit registers a function to be called before quitting due to an
+// error, for example to undo work that will not be completed.
+func OnErrorExit(fn func()) {
+	errorExitHooks = append(errorExitHooks, fn)
+}
+
+// errorExit calls the registered hooks, most recent first, and quits.
+func errorExit() {
+	for i := len(errorExitHooks) - 1; i >= 0; i-- {
+		errorExitHooks[i]()
+	}
+	os.Exit(1)
+}
+
 // ErrCheck checks for an error and quits if it is present
 func ErrCheck(err error, quiet bool, msg string) {
 	if err != nil {
@@ -29,7 +46,7 @@ func ErrCheck(err error, quiet bool, msg string) {
 				fmt.Fprintf(os.Stderr, "%s: %s\n", msg, err.Error())
 			}
 		}
-		os.Exit(1)
+		errorExit()
 	}
 }
 
@@ -44,7 +61,7 @@ func ErrAssert(condition bool, err error, quiet bool, msg string) {
 					fmt.Fprintf(os.Stderr, "%s: %s\n", msg, err.Error())
 				}
 			}
-			os.Exit(1)
+			errorExit()
 		}
 	}
 }
@@ -61,7 +78,7 @@ func Err(quiet bool, msg string) {
 	if !quiet {
 		fmt.Fprintf(os.Stderr, "%s\n", msg)
 	}
-	os.Exit(1)
+	errorExit()
 }
 
 // WarnCheck checks for an error and warns if it is present
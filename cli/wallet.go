@@ -24,6 +24,7 @@ import (
 	"runtime"
 
 	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/external"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/accounts/usbwallet"
 	"github.com/ethereum/go-ethereum/common"
@@ -54,6 +55,12 @@ func ObtainWallets(chainID *big.Int) ([]accounts.Wallet, error) {
 	}
 	wallets = append(wallets, ledgerWallets...)
 
+	externalWallets, err := obtainExternalWallets()
+	if err != nil {
+		return nil, err
+	}
+	wallets = append(wallets, externalWallets...)
+
 	return wallets, nil
 }
 
@@ -82,35 +89,12 @@ func ObtainWallet(chainID *big.Int, address common.Address) (accounts.Wallet, er
 	return wallet, fmt.Errorf("failed to obtain wallet for %s", address.Hex())
 }
 
-func obtainGethWallet(chainID *big.Int, address common.Address) (accounts.Wallet, error) {
-	keydir := DefaultDataDir()
-	if chainID.Cmp(params.MainnetChainConfig.ChainID) == 0 {
-		// Nothing to add for mainnet
-	} else if chainID.Cmp(params.TestnetChainConfig.ChainID) == 0 {
-		keydir = filepath.Join(keydir, "testnet")
-	} else if chainID.Cmp(params.RinkebyChainConfig.ChainID) == 0 {
-		keydir = filepath.Join(keydir, "rinkeby")
-	} else if chainID.Cmp(params.GoerliChainConfig.ChainID) == 0 {
-		keydir = filepath.Join(keydir, "goerli")
-	} else if chainID.Cmp(params.GoerliChainConfig.ChainID) == 0 {
-		keydir = filepath.Join(keydir, "volta")
-	} else if chainID.Cmp(params.GoerliChainConfig.ChainID) == 0 {
-		keydir = filepath.Join(keydir, "energyweb")
-	}
-	keydir = filepath.Join(keydir, "keystore")
-	backends := []accounts.Backend{keystore.NewKeyStore(keydir, keystore.StandardScryptN, keystore.StandardScryptP)}
-	accountManager := accounts.NewManager(nil, backends...)
-	defer accountManager.Close()
-	account := accounts.Account{Address: address}
-	wallet, err := accountManager.Find(account)
-	return wallet, err
-}
-
-func obtainGethWallets(chainID *big.Int) ([]accounts.Wallet, error) {
+// GethKeystoreDir returns the Geth keystore directory for a given chain.
+func GethKeystoreDir(chainID *big.Int) string {
 	keydir := DefaultDataDir()
 	if chainID.Cmp(params.MainnetChainConfig.ChainID) == 0 {
 		// Nothing to add for mainnet
-	} else if chainID.Cmp(params.TestnetChainConfig.ChainID) == 0 {
+	} else if chainID.Cmp(params.RopstenChainConfig.ChainID) == 0 {
 		keydir = filepath.Join(keydir, "testnet")
 	} else if chainID.Cmp(params.RinkebyChainConfig.ChainID) == 0 {
 		keydir = filepath.Join(keydir, "rinkeby")
@@ -122,16 +106,14 @@ func obtainGethWallets(chainID *big.Int) ([]accounts.Wallet, error) {
 		keydir = filepath.Join(keydir, "energyweb")
 	}
 	keydir = filepath.Join(keydir, "keystore")
-	backends := []accounts.Backend{keystore.NewKeyStore(keydir, keystore.StandardScryptN, keystore.StandardScryptP)}
-	accountManager := accounts.NewManager(nil, backends...)
-	defer accountManager.Close()
-	return accountManager.Wallets(), nil
+	return keydir
 }
 
-func obtainParityWallet(chainID *big.Int, address common.Address) (accounts.Wallet, error) {
+// ParityKeystoreDir returns the Parity keystore directory for a given chain.
+func ParityKeystoreDir(chainID *big.Int) (string, error) {
 	keydir, err := homedir.Dir()
 	if err != nil {
-		return nil, fmt.Errorf("Failed to find home directory")
+		return "", fmt.Errorf("Failed to find home directory")
 	}
 	if runtime.GOOS == "windows" {
 		keydir = filepath.Join(keydir, "AppData\\Roaming\\Parity\\Ethereum\\keys")
@@ -140,15 +122,38 @@ func obtainParityWallet(chainID *big.Int, address common.Address) (accounts.Wall
 	} else if runtime.GOOS == "linux" {
 		keydir = filepath.Join(keydir, ".local/share/io.parity.ethereum/keys")
 	} else {
-		return nil, fmt.Errorf("Unsupported operating system")
+		return "", fmt.Errorf("Unsupported operating system")
 	}
 
 	if chainID.Cmp(params.MainnetChainConfig.ChainID) == 0 {
 		keydir = filepath.Join(keydir, "ethereum")
-	} else if chainID.Cmp(params.TestnetChainConfig.ChainID) == 0 {
+	} else if chainID.Cmp(params.RopstenChainConfig.ChainID) == 0 {
 		keydir = filepath.Join(keydir, "test")
 	}
+	return keydir, nil
+}
+
+// KeystoreDir returns the keystore directory in which to write new keys for a
+// given chain.  This is the Geth keystore directory unless only the Parity
+// keystore directory exists.
+func KeystoreDir(chainID *big.Int) (string, error) {
+	gethDir := GethKeystoreDir(chainID)
+	if _, err := os.Stat(gethDir); err == nil {
+		return gethDir, nil
+	}
+	if parityDir, err := ParityKeystoreDir(chainID); err == nil {
+		if _, err := os.Stat(parityDir); err == nil {
+			return parityDir, nil
+		}
+	}
+	if DefaultDataDir() == "" {
+		return "", errors.New("failed to find keystore directory")
+	}
+	return gethDir, nil
+}
 
+func obtainGethWallet(chainID *big.Int, address common.Address) (accounts.Wallet, error) {
+	keydir := GethKeystoreDir(chainID)
 	backends := []accounts.Backend{keystore.NewKeyStore(keydir, keystore.StandardScryptN, keystore.StandardScryptP)}
 	accountManager := accounts.NewManager(nil, backends...)
 	defer accountManager.Close()
@@ -157,25 +162,32 @@ func obtainParityWallet(chainID *big.Int, address common.Address) (accounts.Wall
 	return wallet, err
 }
 
-func obtainParityWallets(chainID *big.Int) ([]accounts.Wallet, error) {
-	keydir, err := homedir.Dir()
+func obtainGethWallets(chainID *big.Int) ([]accounts.Wallet, error) {
+	keydir := GethKeystoreDir(chainID)
+	backends := []accounts.Backend{keystore.NewKeyStore(keydir, keystore.StandardScryptN, keystore.StandardScryptP)}
+	accountManager := accounts.NewManager(nil, backends...)
+	defer accountManager.Close()
+	return accountManager.Wallets(), nil
+}
+
+func obtainParityWallet(chainID *big.Int, address common.Address) (accounts.Wallet, error) {
+	keydir, err := ParityKeystoreDir(chainID)
 	if err != nil {
-		return nil, fmt.Errorf("Failed to find home directory")
-	}
-	if runtime.GOOS == "windows" {
-		keydir = filepath.Join(keydir, "AppData\\Roaming\\Parity\\Ethereum\\keys")
-	} else if runtime.GOOS == "darwin" {
-		keydir = filepath.Join(keydir, "Library/Application Support/io.parity.ethereum/keys")
-	} else if runtime.GOOS == "linux" {
-		keydir = filepath.Join(keydir, ".local/share/io.parity.ethereum/keys")
-	} else {
-		return nil, fmt.Errorf("Unsupported operating system")
+		return nil, err
 	}
 
-	if chainID.Cmp(params.MainnetChainConfig.ChainID) == 0 {
-		keydir = filepath.Join(keydir, "ethereum")
-	} else if chainID.Cmp(params.TestnetChainConfig.ChainID) == 0 {
-		keydir = filepath.Join(keydir, "test")
+	backends := []accounts.Backend{keystore.NewKeyStore(keydir, keystore.StandardScryptN, keystore.StandardScryptP)}
+	accountManager := accounts.NewManager(nil, backends...)
+	defer accountManager.Close()
+	account := accounts.Account{Address: address}
+	wallet, err := accountManager.Find(account)
+	return wallet, err
+}
+
+func obtainParityWallets(chainID *big.Int) ([]accounts.Wallet, error) {
+	keydir, err := ParityKeystoreDir(chainID)
+	if err != nil {
+		return nil, err
 	}
 
 	backends := []accounts.Backend{keystore.NewKeyStore(keydir, keystore.StandardScryptN, keystore.StandardScryptP)}
@@ -207,6 +219,26 @@ func obtainLedgerWallets(chainID *big.Int) ([]accounts.Wallet, error) {
 	return accountManager.Wallets(), nil
 }
 
+// ObtainExternalSigner connects to an external signer at the given URL or IPC path.
+func ObtainExternalSigner(endpoint string) (*external.ExternalSigner, error) {
+	signer, err := external.NewExternalSigner(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to external signer %s: %v", endpoint, err)
+	}
+	return signer, nil
+}
+
+func obtainExternalWallets() ([]accounts.Wallet, error) {
+	if viper.GetString("external-signer") == "" {
+		return nil, nil
+	}
+	signer, err := ObtainExternalSigner(viper.GetString("external-signer"))
+	if err != nil {
+		return nil, err
+	}
+	return []accounts.Wallet{signer}, nil
+}
+
 // ObtainAccount fetches the account for a given address
 func ObtainAccount(wallet *accounts.Wallet, address *common.Address, passphrase string) (*accounts.Account, error) {
 	for _, account := range (*wallet).Accounts() {
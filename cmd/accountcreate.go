@@ -0,0 +1,72 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/spf13/cobra"
+	"github.com/wealdtech/ethereal/cli"
+)
+
+var accountCreatePassphrase string
+
+// accountCreateCmd represents the account create command
+var accountCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a new account",
+	Long: `Create a new account with a random key, stored in the local keystore.  For example:
+
+    ethereal account create --passphrase=secret
+
+The account is stored in the Geth keystore, or the Parity keystore if only that is present, so will be visible to 'ethereal account list'.
+
+In quiet mode this will return 0 if the account was created, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli.Assert(accountCreatePassphrase != "", quiet, "--passphrase is required")
+
+		ks := accountKeystore()
+		account, err := ks.NewAccount(accountCreatePassphrase)
+		cli.ErrCheck(err, quiet, "Failed to create account")
+
+		outputAccount(account)
+		os.Exit(_exit_success)
+	},
+}
+
+// accountKeystore returns the keystore in which new accounts are stored.
+func accountKeystore() *keystore.KeyStore {
+	keydir, err := cli.KeystoreDir(chainID)
+	cli.ErrCheck(err, quiet, "Failed to find keystore")
+	outputIf(debug, fmt.Sprintf("Keystore is %s", keydir))
+	return keystore.NewKeyStore(keydir, keystore.StandardScryptN, keystore.StandardScryptP)
+}
+
+// outputAccount outputs the address of an account, and its location if verbose.
+func outputAccount(account accounts.Account) {
+	if quiet {
+		return
+	}
+	fmt.Println(account.Address.Hex())
+	outputIf(verbose, fmt.Sprintf("Location:\t%s", account.URL))
+}
+
+func init() {
+	offlineCmds["account:create"] = true
+	accountCmd.AddCommand(accountCreateCmd)
+	accountCreateCmd.Flags().StringVar(&accountCreatePassphrase, "passphrase", "", "passphrase for the new account")
+}
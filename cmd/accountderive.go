@@ -0,0 +1,83 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/cobra"
+	"github.com/wealdtech/ethereal/cli"
+	"github.com/wealdtech/ethereal/util"
+)
+
+var accountDeriveMnemonic string
+var accountDerivePassphrase string
+var accountDerivePath string
+var accountDeriveCount uint
+var accountDeriveNoChecksum bool
+
+// accountDeriveCmd represents the account derive command
+var accountDeriveCmd = &cobra.Command{
+	Use:   "derive",
+	Short: "Derive addresses from a mnemonic",
+	Long: `Derive addresses from a BIP-39 mnemonic.  For example:
+
+    ethereal account derive --mnemonic="test test test test test test test test test test test junk" --count=5
+
+Addresses are derived starting at --path, incrementing the last component of the path for each subsequent address.  The default path is the standard Ethereum path m/44'/60'/0'/0/0.
+
+The mnemonic is checked against the BIP-39 word list and its checksum validated; --nochecksum disables this check, for mnemonics generated by tools that do not follow BIP-39.
+
+With --verbose the private key of each address is also displayed.
+
+In quiet mode this will return 0 if the addresses were successfully derived, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli.Assert(accountDeriveMnemonic != "", quiet, "--mnemonic is required")
+		cli.Assert(accountDeriveCount > 0, quiet, "--count must be at least 1")
+
+		path, err := accounts.ParseDerivationPath(accountDerivePath)
+		cli.ErrCheck(err, quiet, fmt.Sprintf("Invalid path %s", accountDerivePath))
+		seed, err := util.MnemonicToSeed(accountDeriveMnemonic, accountDerivePassphrase, !accountDeriveNoChecksum)
+		cli.ErrCheck(err, quiet, "Failed to derive addresses")
+
+		next := accounts.DefaultIterator(path)
+		for i := uint(0); i < accountDeriveCount; i++ {
+			path := next()
+			key, err := util.DeriveKey(seed, path)
+			cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to derive key for %v", path))
+			if quiet {
+				continue
+			}
+			if verbose {
+				fmt.Printf("%v\t%s\t0x%064x\n", path, crypto.PubkeyToAddress(key.PublicKey).Hex(), key.D)
+			} else {
+				fmt.Printf("%v\t%s\n", path, crypto.PubkeyToAddress(key.PublicKey).Hex())
+			}
+		}
+		os.Exit(_exit_success)
+	},
+}
+
+func init() {
+	offlineCmds["account:derive"] = true
+	accountCmd.AddCommand(accountDeriveCmd)
+	accountDeriveCmd.Flags().StringVar(&accountDeriveMnemonic, "mnemonic", "", "BIP-39 mnemonic from which to derive addresses")
+	accountDeriveCmd.Flags().StringVar(&accountDerivePassphrase, "passphrase", "", "passphrase for the mnemonic")
+	accountDeriveCmd.Flags().StringVar(&accountDerivePath, "path", util.DefaultHDPath, "BIP-32 derivation path of the first address")
+	accountDeriveCmd.Flags().UintVar(&accountDeriveCount, "count", 10, "number of addresses to derive")
+	accountDeriveCmd.Flags().BoolVar(&accountDeriveNoChecksum, "nochecksum", false, "do not validate the mnemonic against the BIP-39 word list and checksum")
+}
@@ -0,0 +1,92 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+	"github.com/wealdtech/ethereal/cli"
+	"github.com/wealdtech/ethereal/util"
+)
+
+var accountExportAddress string
+var accountExportPassphrase string
+var accountExportNewPassphrase string
+var accountExportFile string
+var accountExportKDF string
+var accountExportScryptN int
+var accountExportScryptP int
+var accountExportPBKDF2C int
+
+// accountExportCmd represents the account export command
+var accountExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export an account",
+	Long: `Export an account from the local keystore as a V3 keystore.  For example:
+
+    ethereal account export --address=0x5FfC014343cd971B7eb70732021E26C35B744cc4 --passphrase=secret --file=export.json
+
+The exported keystore is encrypted with --newpassphrase if supplied, otherwise with the existing passphrase.  The key derivation function is set with --kdf, either 'scrypt' (the default) or 'pbkdf2'; the cost of scrypt is set with --scryptn and --scryptp, and the cost of pbkdf2 with --pbkdf2c.
+
+If --file is not supplied the keystore is written to standard output.
+
+In quiet mode this will return 0 if the account was exported, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli.Assert(accountExportAddress != "", quiet, "--address is required")
+		cli.Assert(common.IsHexAddress(accountExportAddress), quiet, "Invalid address")
+		cli.Assert(accountExportPassphrase != "", quiet, "--passphrase is required")
+
+		address := common.HexToAddress(accountExportAddress)
+		key, err := util.PrivateKeyForAccount(chainID, address, accountExportPassphrase)
+		cli.ErrCheck(err, quiet, "Failed to access account")
+
+		newPassphrase := accountExportNewPassphrase
+		if newPassphrase == "" {
+			newPassphrase = accountExportPassphrase
+		}
+		keyJSON, err := util.EncryptKey(key, newPassphrase, &util.KeystoreParams{
+			KDF:     accountExportKDF,
+			ScryptN: accountExportScryptN,
+			ScryptP: accountExportScryptP,
+			PBKDF2C: accountExportPBKDF2C,
+		})
+		cli.ErrCheck(err, quiet, "Failed to encrypt account")
+
+		if accountExportFile != "" {
+			err = ioutil.WriteFile(accountExportFile, keyJSON, 0600)
+			cli.ErrCheck(err, quiet, "Failed to write keystore")
+			outputIf(verbose, fmt.Sprintf("Keystore written to %s", accountExportFile))
+		} else if !quiet {
+			fmt.Println(string(keyJSON))
+		}
+		os.Exit(_exit_success)
+	},
+}
+
+func init() {
+	offlineCmds["account:export"] = true
+	accountCmd.AddCommand(accountExportCmd)
+	accountExportCmd.Flags().StringVar(&accountExportAddress, "address", "", "address of the account to export")
+	accountExportCmd.Flags().StringVar(&accountExportPassphrase, "passphrase", "", "passphrase for the account")
+	accountExportCmd.Flags().StringVar(&accountExportNewPassphrase, "newpassphrase", "", "passphrase for the exported keystore (default the existing passphrase)")
+	accountExportCmd.Flags().StringVar(&accountExportFile, "file", "", "file to which to write the keystore (default standard output)")
+	accountExportCmd.Flags().StringVar(&accountExportKDF, "kdf", "scrypt", "key derivation function for the exported keystore: 'scrypt' or 'pbkdf2'")
+	accountExportCmd.Flags().IntVar(&accountExportScryptN, "scryptn", util.StandardScryptN, "scrypt N parameter")
+	accountExportCmd.Flags().IntVar(&accountExportScryptP, "scryptp", util.StandardScryptP, "scrypt P parameter")
+	accountExportCmd.Flags().IntVar(&accountExportPBKDF2C, "pbkdf2c", 262144, "number of pbkdf2 iterations")
+}
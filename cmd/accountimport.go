@@ -0,0 +1,82 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/cobra"
+	"github.com/wealdtech/ethereal/cli"
+)
+
+var accountImportPrivateKey string
+var accountImportKeystore string
+var accountImportPassphrase string
+var accountImportNewPassphrase string
+
+// accountImportCmd represents the account import command
+var accountImportCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import an account",
+	Long: `Import an account from a private key or a keystore file in to the local keystore.  For example:
+
+    ethereal account import --privatekey=0x0000000000000000000000000000000000000000000000000000000000000001 --passphrase=secret
+
+    ethereal account import --keystore=UTC--2021-01-01T00-00-00.000000000Z--7e5f4552091a69125d5dfcb7b8c2659029395bdf --passphrase=secret
+
+When importing a private key --passphrase is the passphrase with which the account is encrypted.  When importing a keystore file --passphrase is the passphrase of the file, and the account is encrypted with --newpassphrase if supplied, otherwise with the same passphrase.
+
+The account is stored in the Geth keystore, or the Parity keystore if only that is present, so will be visible to 'ethereal account list'.
+
+In quiet mode this will return 0 if the account was imported, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli.Assert(accountImportPrivateKey != "" || accountImportKeystore != "", quiet, "--privatekey or --keystore is required")
+		cli.Assert(accountImportPrivateKey == "" || accountImportKeystore == "", quiet, "only one of --privatekey and --keystore can be supplied")
+		cli.Assert(accountImportPassphrase != "", quiet, "--passphrase is required")
+
+		ks := accountKeystore()
+		var account accounts.Account
+		if accountImportPrivateKey != "" {
+			key, err := crypto.HexToECDSA(strings.TrimPrefix(accountImportPrivateKey, "0x"))
+			cli.ErrCheck(err, quiet, "Invalid private key")
+			account, err = ks.ImportECDSA(key, accountImportPassphrase)
+			cli.ErrCheck(err, quiet, "Failed to import account")
+		} else {
+			keyJSON, err := ioutil.ReadFile(accountImportKeystore)
+			cli.ErrCheck(err, quiet, "Failed to read keystore")
+			newPassphrase := accountImportNewPassphrase
+			if newPassphrase == "" {
+				newPassphrase = accountImportPassphrase
+			}
+			account, err = ks.Import(keyJSON, accountImportPassphrase, newPassphrase)
+			cli.ErrCheck(err, quiet, "Failed to import account")
+		}
+
+		outputAccount(account)
+		os.Exit(_exit_success)
+	},
+}
+
+func init() {
+	offlineCmds["account:import"] = true
+	accountCmd.AddCommand(accountImportCmd)
+	accountImportCmd.Flags().StringVar(&accountImportPrivateKey, "privatekey", "", "private key of the account to import")
+	accountImportCmd.Flags().StringVar(&accountImportKeystore, "keystore", "", "keystore file of the account to import")
+	accountImportCmd.Flags().StringVar(&accountImportPassphrase, "passphrase", "", "passphrase for the account")
+	accountImportCmd.Flags().StringVar(&accountImportNewPassphrase, "newpassphrase", "", "new passphrase for an account imported from a keystore file (default the existing passphrase)")
+}
@@ -15,11 +15,18 @@ package cmd
 
 import (
 	"fmt"
+	"math/big"
 	"os"
+	"strings"
 
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/cobra"
 	"github.com/wealdtech/ethereal/cli"
-	ens "github.com/wealdtech/go-ens/v2"
+	"github.com/wealdtech/ethereal/util"
+	"github.com/wealdtech/ethereal/util/contracts"
+	ens "github.com/wealdtech/go-ens/v3"
 	string2eth "github.com/wealdtech/go-string2eth"
 )
 
@@ -36,33 +43,47 @@ In quiet mode this will return 0 if any accounts are found, otherwise 1.`,
 		wallets, err := cli.ObtainWallets(chainID)
 		foundAccounts := false
 		if err == nil {
+			walletAccounts := make([]accounts.Account, 0)
 			for _, wallet := range wallets {
-				for _, account := range wallet.Accounts() {
-					foundAccounts = true
-					if !quiet {
-						if !verbose {
-							fmt.Println(account.Address.Hex())
-						} else {
-							fmt.Printf("Location:\t%s\n", account.URL)
-							fmt.Printf("Address:\t%s\n", account.Address.Hex())
-							if !offline {
-								name, err := ens.ReverseResolve(client, account.Address)
-								if err == nil {
-									fmt.Printf("Name:\t\t%s\n", name)
-								}
-								ctx, cancel := localContext()
-								defer cancel()
-								balance, err := client.BalanceAt(ctx, account.Address, nil)
-								if err == nil {
-									fmt.Printf("Balance:\t%s\n", string2eth.WeiToString(balance, true))
-								}
-								nonce, err := client.PendingNonceAt(ctx, account.Address)
-								if err == nil {
-									fmt.Printf("Next nonce:\t%v\n", nonce)
-								}
+				walletAccounts = append(walletAccounts, wallet.Accounts()...)
+			}
+			foundAccounts = len(walletAccounts) > 0
+
+			var balances []*big.Int
+			if verbose && !quiet && !offline {
+				addresses := make([]common.Address, len(walletAccounts))
+				for i := range walletAccounts {
+					addresses[i] = walletAccounts[i].Address
+				}
+				balances, err = etherBalances(addresses)
+				if err != nil {
+					outputIf(debug, fmt.Sprintf("Failed to obtain balances: %v", err))
+				}
+			}
+
+			for i, account := range walletAccounts {
+				if !quiet {
+					if !verbose {
+						fmt.Println(account.Address.Hex())
+					} else {
+						fmt.Printf("Location:\t%s\n", account.URL)
+						fmt.Printf("Address:\t%s\n", account.Address.Hex())
+						if !offline {
+							name, err := ens.ReverseResolve(client, account.Address)
+							if err == nil {
+								fmt.Printf("Name:\t\t%s\n", name)
+							}
+							if balances != nil {
+								fmt.Printf("Balance:\t%s\n", string2eth.WeiToString(balances[i], true))
+							}
+							ctx, cancel := localContext()
+							nonce, err := client.PendingNonceAt(ctx, account.Address)
+							cancel()
+							if err == nil {
+								fmt.Printf("Next nonce:\t%v\n", nonce)
 							}
-							fmt.Println("")
 						}
+						fmt.Println("")
 					}
 				}
 			}
@@ -78,6 +99,49 @@ In quiet mode this will return 0 if any accounts are found, otherwise 1.`,
 	},
 }
 
+// etherBalances obtains the Ether balances of a number of addresses, using
+// Multicall3 if it is available to obtain them in a single call.
+func etherBalances(addresses []common.Address) ([]*big.Int, error) {
+	ctx, cancel := localContext()
+	defer cancel()
+	available, err := util.MulticallAvailable(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make([]*big.Int, len(addresses))
+	if !available {
+		for i, address := range addresses {
+			balances[i], err = client.BalanceAt(ctx, address, nil)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return balances, nil
+	}
+
+	multicallAbi, err := abi.JSON(strings.NewReader(contracts.Multicall3ABI))
+	if err != nil {
+		return nil, err
+	}
+	calls := make([]contracts.Multicall3Call3, len(addresses))
+	for i, address := range addresses {
+		data, err := multicallAbi.Pack("getEthBalance", address)
+		if err != nil {
+			return nil, err
+		}
+		calls[i] = contracts.Multicall3Call3{Target: util.Multicall3Address, CallData: data}
+	}
+	results, err := util.Multicall(ctx, client, common.Address{}, calls)
+	if err != nil {
+		return nil, err
+	}
+	for i, result := range results {
+		balances[i] = new(big.Int).SetBytes(result.ReturnData)
+	}
+	return balances, nil
+}
+
 func init() {
 	accountCmd.AddCommand(accountListCmd)
 }
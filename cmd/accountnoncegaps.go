@@ -0,0 +1,108 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/wealdtech/ethereal/cli"
+	"github.com/wealdtech/ethereal/util"
+	ens "github.com/wealdtech/go-ens/v3"
+)
+
+var accountNonceGapsAddress string
+var accountNonceGapsFill bool
+
+// accountNonceGapsCmd represents the account nonce gaps command
+var accountNonceGapsCmd = &cobra.Command{
+	Use:   "gaps",
+	Short: "Find and fill gaps in the nonces of an account",
+	Long: `Find gaps in the nonces of an account's outstanding transactions, which stop later transactions from being mined.  For example:
+
+    ethereal account nonce gaps --address=0x5FfC014343cd971B7eb70732021E26C35B744cc4
+
+Outstanding transactions are those in the node's transaction pool and those reserved in the local nonce store.  If --fill is supplied then each gap is filled with a zero-value transfer from the account to itself.  This should only be run when no other instances of Ethereal are creating transactions for the account.
+
+In quiet mode this will return 0 if there are no gaps (or they have all been filled), otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli.Assert(accountNonceGapsAddress != "", quiet, "--address is required")
+		address, err := ens.Resolve(client, accountNonceGapsAddress)
+		cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to obtain address of %s", accountNonceGapsAddress))
+
+		ctx, cancel := localContext()
+		defer cancel()
+		minedNonce, err := client.NonceAt(ctx, address, nil)
+		cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to obtain nonce for %s", accountNonceGapsAddress))
+		chainNonce, err := pendingNonce(address)
+		cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to obtain nonce for %s", accountNonceGapsAddress))
+
+		next := chainNonce
+		store, err := nonceStore(address)
+		if err == nil {
+			next, err = store.Peek(chainNonce)
+			cli.ErrCheck(err, quiet, "Failed to access nonce store")
+		}
+
+		ctx, cancel = localContext()
+		defer cancel()
+		used, err := util.PoolNonces(ctx, rpcClient, address)
+		if err != nil {
+			// Node does not expose its transaction pool; assume that pending transactions are contiguous
+			outputIf(debug, fmt.Sprintf("Failed to obtain transaction pool (%v)", err))
+			used = make(map[uint64]bool)
+			for nonce := minedNonce; nonce < chainNonce; nonce++ {
+				used[nonce] = true
+			}
+		}
+
+		gaps := util.NonceGaps(minedNonce, next, used)
+		if len(gaps) == 0 {
+			outputIf(verbose, "No gaps")
+			os.Exit(_exit_success)
+		}
+
+		if !accountNonceGapsFill {
+			for _, gap := range gaps {
+				outputIf(!quiet, fmt.Sprintf("%d", gap))
+			}
+			os.Exit(_exit_failure)
+		}
+
+		for _, gap := range gaps {
+			nonce = int64(gap)
+			signedTx, err := createSignedTransaction(address, &address, big.NewInt(0), 21000, nil)
+			cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to create transaction for nonce %d", gap))
+
+			ctx, cancel := localContext()
+			defer cancel()
+			err = client.SendTransaction(ctx, signedTx)
+			cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to send transaction for nonce %d", gap))
+			handleSubmittedTransaction(signedTx, log.Fields{
+				"group":   "account",
+				"command": "nonce gaps",
+			}, false)
+		}
+	},
+}
+
+func init() {
+	accountNonceCmd.AddCommand(accountNonceGapsCmd)
+	accountNonceGapsCmd.Flags().StringVar(&accountNonceGapsAddress, "address", "", "Address of the account for which to find nonce gaps")
+	accountNonceGapsCmd.Flags().BoolVar(&accountNonceGapsFill, "fill", false, "Fill gaps with zero-value transactions")
+	addTransactionFlags(accountNonceGapsCmd, "the account")
+}
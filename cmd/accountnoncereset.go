@@ -0,0 +1,57 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wealdtech/ethereal/cli"
+	ens "github.com/wealdtech/go-ens/v3"
+)
+
+var accountNonceResetAddress string
+
+// accountNonceResetCmd represents the account nonce reset command
+var accountNonceResetCmd = &cobra.Command{
+	Use:   "reset",
+	Short: "Reset the local nonce store for an account",
+	Long: `Reset the local nonce store for an account to the nonce reported by the node, discarding any outstanding reservations.  For example:
+
+    ethereal account nonce reset --address=0x5FfC014343cd971B7eb70732021E26C35B744cc4
+
+This should only be run when no other instances of Ethereal are creating transactions for the account.
+
+In quiet mode this will return 0 if the nonce store is reset, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli.Assert(accountNonceResetAddress != "", quiet, "--address is required")
+		address, err := ens.Resolve(client, accountNonceResetAddress)
+		cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to obtain address of %s", accountNonceResetAddress))
+
+		chainNonce, err := pendingNonce(address)
+		cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to obtain nonce for %s", accountNonceResetAddress))
+
+		store, err := nonceStore(address)
+		cli.ErrCheck(err, quiet, "Failed to access nonce store")
+		err = store.Reset(chainNonce)
+		cli.ErrCheck(err, quiet, "Failed to reset nonce store")
+
+		outputIf(!quiet, fmt.Sprintf("Next nonce is %d", chainNonce))
+	},
+}
+
+func init() {
+	accountNonceCmd.AddCommand(accountNonceResetCmd)
+	accountNonceResetCmd.Flags().StringVar(&accountNonceResetAddress, "address", "", "Address of the account for which to reset the nonce store")
+}
@@ -0,0 +1,68 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+	"github.com/wealdtech/ethereal/cli"
+)
+
+var accountPassphraseAddress string
+var accountPassphrasePassphrase string
+var accountPassphraseNewPassphrase string
+
+// accountPassphraseCmd represents the account passphrase command
+var accountPassphraseCmd = &cobra.Command{
+	Use:   "passphrase",
+	Short: "Change the passphrase of an account",
+	Long: `Change the passphrase of an account in the local keystore.  For example:
+
+    ethereal account passphrase --address=0x5FfC014343cd971B7eb70732021E26C35B744cc4 --passphrase=secret --newpassphrase=newsecret
+
+The account is re-encrypted in place with the new passphrase.
+
+In quiet mode this will return 0 if the passphrase was changed, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli.Assert(accountPassphraseAddress != "", quiet, "--address is required")
+		cli.Assert(common.IsHexAddress(accountPassphraseAddress), quiet, "Invalid address")
+		cli.Assert(accountPassphrasePassphrase != "", quiet, "--passphrase is required")
+		cli.Assert(accountPassphraseNewPassphrase != "", quiet, "--newpassphrase is required")
+
+		address := common.HexToAddress(accountPassphraseAddress)
+		wallet, err := cli.ObtainWallet(chainID, address)
+		cli.ErrCheck(err, quiet, "Failed to obtain account")
+		account, err := cli.ObtainAccount(&wallet, &address, "")
+		cli.ErrCheck(err, quiet, "Failed to obtain account")
+
+		ks := keystore.NewKeyStore(filepath.Dir(account.URL.Path), keystore.StandardScryptN, keystore.StandardScryptP)
+		err = ks.Update(*account, accountPassphrasePassphrase, accountPassphraseNewPassphrase)
+		cli.ErrCheck(err, quiet, "Failed to change passphrase")
+
+		outputIf(verbose, "Passphrase changed")
+		os.Exit(_exit_success)
+	},
+}
+
+func init() {
+	offlineCmds["account:passphrase"] = true
+	accountCmd.AddCommand(accountPassphraseCmd)
+	accountPassphraseCmd.Flags().StringVar(&accountPassphraseAddress, "address", "", "address of the account")
+	accountPassphraseCmd.Flags().StringVar(&accountPassphrasePassphrase, "passphrase", "", "current passphrase for the account")
+	accountPassphraseCmd.Flags().StringVar(&accountPassphraseNewPassphrase, "newpassphrase", "", "new passphrase for the account")
+}
@@ -0,0 +1,395 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"sync"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/wealdtech/ethereal/cli"
+	"github.com/wealdtech/ethereal/util"
+	ens "github.com/wealdtech/go-ens/v3"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+var batchFile string
+var batchResultsFile string
+var batchConcurrency int
+
+// batchSpec describes how the transfers in a batch are carried out.
+type batchSpec struct {
+	// ether is true if the amounts are in Ether, in which case they are paid
+	// from the same balance as the fees.
+	ether bool
+	// balance is the balance from which the amounts are paid.
+	balance *big.Int
+	// parseAmount parses the amount of a transfer.
+	parseAmount func(input string) (*big.Int, error)
+	// formatAmount formats an amount for output.
+	formatAmount func(amount *big.Int) string
+	// txParams provides the recipient, value and data of the transaction that
+	// carries out a transfer.
+	txParams func(to common.Address, amount *big.Int, data string) (*common.Address, *big.Int, []byte, error)
+}
+
+// batchItem is a validated transfer that is to be sent.
+type batchItem struct {
+	transfer *util.BatchTransfer
+	to       common.Address
+	amount   *big.Int
+	txTo     *common.Address
+	txValue  *big.Int
+	txData   []byte
+	gas      uint64
+	// nonce is the nonce that must be used for the transaction, if any.
+	nonce *uint64
+	tx    *types.Transaction
+}
+
+// runBatchTransfers validates, sends and optionally waits for the transfers in
+// the batch file, recording the results so that the batch can be resumed.
+// It exits with a suitable status.
+func runBatchTransfers(fromAddress common.Address, spec *batchSpec, logFields log.Fields) {
+	cli.Assert(!offline, quiet, "Offline mode not supported with --batch")
+	cli.Assert(!unsigned, quiet, "--unsigned is not supported with --batch")
+	cli.Assert(!simulate, quiet, "--simulate is not supported with --batch")
+	cli.Assert(!viper.GetBool("escalate"), quiet, "--escalate is not supported with --batch")
+	cli.Assert(batchConcurrency > 0, quiet, "--batchconcurrency must be at least 1")
+
+	transfers, err := util.ParseBatchTransfers(batchFile)
+	cli.ErrCheck(err, quiet, "Failed to parse batch file")
+	resultsPath := batchResultsFile
+	if resultsPath == "" {
+		resultsPath = batchFile + ".results"
+	}
+	results, err := util.ReadBatchResults(resultsPath)
+	cli.ErrCheck(err, quiet, "Failed to read batch results")
+
+	// Validate every transfer before sending any of them
+	items := make([]*batchItem, 0, len(transfers))
+	invalid := 0
+	reconciled := false
+	total := big.NewInt(0)
+	for _, transfer := range transfers {
+		var requiredNonce *uint64
+		if result, exists := results[transfer.Line]; exists {
+			cli.Assert(result.Matches(transfer), quiet, fmt.Sprintf("Line %d of batch file does not match its result in %s", transfer.Line, resultsPath))
+			if result.Unconfirmed() {
+				requiredNonce, err = reconcileBatchResult(fromAddress, result)
+				cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to check transaction for line %d", transfer.Line))
+				reconciled = true
+			}
+			if result.Submitted() {
+				outputIf(verbose, fmt.Sprintf("Line %d already submitted as %s", transfer.Line, result.Hash))
+				continue
+			}
+		}
+		item, err := validateBatchTransfer(fromAddress, transfer, spec)
+		if err != nil {
+			outputIf(!quiet, fmt.Sprintf("Line %d: %v", transfer.Line, err))
+			invalid++
+			continue
+		}
+		item.nonce = requiredNonce
+		total.Add(total, item.amount)
+		items = append(items, item)
+	}
+	cli.Assert(invalid == 0, quiet, fmt.Sprintf("Batch file contains %d invalid transfer(s)", invalid))
+	if reconciled {
+		err = util.WriteBatchResults(resultsPath, results)
+		cli.ErrCheck(err, quiet, "Failed to write batch results")
+	}
+
+	if len(items) > 0 {
+		// Ensure that the transfers and their fees can be paid
+		err = setupFees()
+		cli.ErrCheck(err, quiet, "Failed to set up transaction fees")
+		feeCap := gasPrice
+		if dynamicFees {
+			feeCap = maxFeePerGas
+		}
+		fees := big.NewInt(0)
+		for _, item := range items {
+			fees.Add(fees, new(big.Int).Mul(feeCap, new(big.Int).SetUint64(item.gas)))
+		}
+		ctx, cancel := localContext()
+		etherBalance, err := client.BalanceAt(ctx, fromAddress, nil)
+		cancel()
+		cli.ErrCheck(err, quiet, "Failed to obtain balance of address from which to send funds")
+		if spec.ether {
+			required := new(big.Int).Add(total, fees)
+			cli.Assert(etherBalance.Cmp(required) >= 0, quiet, fmt.Sprintf("Balance of %s insufficient for transfers of %s plus fees of up to %s", string2eth.WeiToString(etherBalance, true), string2eth.WeiToString(total, true), string2eth.WeiToString(fees, true)))
+		} else {
+			cli.Assert(spec.balance.Cmp(total) >= 0, quiet, fmt.Sprintf("Balance of %s insufficient for transfers of %s", spec.formatAmount(spec.balance), spec.formatAmount(total)))
+			cli.Assert(etherBalance.Cmp(fees) >= 0, quiet, fmt.Sprintf("Balance of %s insufficient for fees of up to %s", string2eth.WeiToString(etherBalance, true), string2eth.WeiToString(fees, true)))
+		}
+		outputIf(verbose, fmt.Sprintf("Sending %d transfer(s) totalling %s", len(items), spec.formatAmount(total)))
+
+		// Create and sign the transactions.  Transfers whose earlier
+		// transactions may yet be mined reuse their nonces, so that only one
+		// of them can be; the rest are given consecutive nonces.
+		newNonces := uint64(0)
+		for _, item := range items {
+			if item.nonce == nil {
+				newNonces++
+			}
+		}
+		var nextNewNonce uint64
+		if newNonces > 0 {
+			nextNewNonce, err = reserveNonces(fromAddress, newNonces)
+			cli.ErrCheck(err, quiet, "Failed to obtain nonces for batch")
+		}
+		for _, item := range items {
+			if item.nonce != nil {
+				nonce = int64(*item.nonce)
+			} else {
+				nonce = int64(nextNewNonce)
+				nextNewNonce++
+			}
+			tx, err := createTransaction(fromAddress, item.txTo, item.txValue, item.gas, item.txData)
+			cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to create transaction for line %d", item.transfer.Line))
+			item.tx, err = signTransaction(fromAddress, tx)
+			cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to sign transaction for line %d", item.transfer.Line))
+		}
+
+		// Record the transactions before sending any of them, so that a batch
+		// that is interrupted while sending does not send them again.
+		for _, item := range items {
+			txNonce := item.tx.Nonce()
+			results[item.transfer.Line] = &util.BatchResult{
+				Line:   item.transfer.Line,
+				To:     item.transfer.To,
+				Amount: item.transfer.Amount,
+				Data:   item.transfer.Data,
+				Hash:   item.tx.Hash().Hex(),
+				Nonce:  &txNonce,
+				Status: util.BatchStatusSigned,
+			}
+		}
+		err = util.WriteBatchResults(resultsPath, results)
+		cli.ErrCheck(err, quiet, "Failed to write batch results")
+
+		sendBatchTransactions(items, results, resultsPath, logFields)
+	}
+
+	if viper.GetBool("wait") {
+		waitForBatchTransactions(results, resultsPath)
+	}
+
+	os.Exit(outputBatchResults(transfers, results))
+}
+
+// reconcileBatchResult checks the network for the transaction of a transfer
+// that was signed but may not have been sent, so that the transfer is not made
+// twice.  If the node has the transaction the result is updated to show it as
+// submitted.  Otherwise the nonce that must be used if the transfer is sent
+// again is returned, or nil if any nonce can be used.
+func reconcileBatchResult(fromAddress common.Address, result *util.BatchResult) (*uint64, error) {
+	ctx, cancel := localContext()
+	defer cancel()
+	_, _, err := client.TransactionByHash(ctx, common.HexToHash(result.Hash))
+	if err == nil {
+		result.Status = util.BatchStatusSubmitted
+		result.Error = ""
+		return nil, nil
+	}
+	if !errors.Is(err, ethereum.NotFound) {
+		return nil, err
+	}
+	if result.Nonce == nil {
+		return nil, nil
+	}
+	chainNonce, err := client.NonceAt(ctx, fromAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+	if chainNonce > *result.Nonce {
+		// The nonce has been used by another transaction, so this one can
+		// never be mined.
+		return nil, nil
+	}
+	// The transaction could still reach the network, so a replacement must
+	// use the same nonce to ensure that at most one of them is mined.
+	return result.Nonce, nil
+}
+
+// validateBatchTransfer validates a transfer and obtains the details of the
+// transaction that will carry it out.
+func validateBatchTransfer(fromAddress common.Address, transfer *util.BatchTransfer, spec *batchSpec) (*batchItem, error) {
+	to, err := ens.Resolve(client, transfer.To)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve %s: %v", transfer.To, err)
+	}
+	amount, err := spec.parseAmount(transfer.Amount)
+	if err != nil {
+		return nil, fmt.Errorf("invalid amount %s: %v", transfer.Amount, err)
+	}
+	if amount.Sign() < 0 {
+		return nil, fmt.Errorf("negative amount %s", transfer.Amount)
+	}
+	txTo, txValue, txData, err := spec.txParams(to, amount, transfer.Data)
+	if err != nil {
+		return nil, err
+	}
+	gas := gasLimit
+	if gas == 0 {
+		gas, err = estimateGas(fromAddress, txTo, txValue, txData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate gas: %v", err)
+		}
+	}
+	return &batchItem{
+		transfer: transfer,
+		to:       to,
+		amount:   amount,
+		txTo:     txTo,
+		txValue:  txValue,
+		txData:   txData,
+		gas:      gas,
+	}, nil
+}
+
+// sendBatchTransactions sends the signed transactions of a batch with bounded
+// concurrency, recording the result of each as it is sent.
+func sendBatchTransactions(items []*batchItem, results map[int]*util.BatchResult, resultsPath string, logFields log.Fields) {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, batchConcurrency)
+	failed := false
+	for _, item := range items {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(item *batchItem) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			ctx, cancel := localContext()
+			err := client.SendTransaction(ctx, item.tx)
+			cancel()
+
+			mu.Lock()
+			defer mu.Unlock()
+			result := results[item.transfer.Line]
+			result.Status = util.BatchStatusSubmitted
+			if err != nil {
+				result.Status = util.BatchStatusError
+				result.Error = err.Error()
+				outputIf(!quiet, fmt.Sprintf("Line %d: failed to send transaction: %v", item.transfer.Line, err))
+				failed = true
+			} else {
+				nonceSent(item.tx.Nonce())
+				fields := log.Fields{
+					"batchline":      item.transfer.Line,
+					"batchrecipient": item.to.Hex(),
+					"batchamount":    item.amount.String(),
+				}
+				for k, v := range logFields {
+					fields[k] = v
+				}
+				logTransaction(item.tx, fields)
+				outputIf(verbose, fmt.Sprintf("Line %d: submitted %s", item.transfer.Line, item.tx.Hash().Hex()))
+			}
+			if err := util.WriteBatchResults(resultsPath, results); err != nil {
+				outputIf(!quiet, fmt.Sprintf("Failed to write batch results: %v", err))
+			}
+		}(item)
+	}
+	wg.Wait()
+	if failed {
+		outputIf(!quiet, "Transactions with nonces after those that failed to send will not be mined until the gaps are filled; use 'ethereal account nonce gaps --fill' to fill them")
+	}
+}
+
+// waitForBatchTransactions waits for the submitted transactions of a batch to
+// be mined, recording the result of each.
+func waitForBatchTransactions(results map[int]*util.BatchResult, resultsPath string) {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, batchConcurrency)
+	for _, result := range results {
+		if result.Status != util.BatchStatusSubmitted && result.Status != util.BatchStatusNotMined {
+			continue
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(result *util.BatchResult) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			receipt, mined := util.WaitForTransaction(client, common.HexToHash(result.Hash), viper.GetUint64("confirmations"), viper.GetDuration("limit"))
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case !mined:
+				result.Status = util.BatchStatusNotMined
+			case receipt.Status == types.ReceiptStatusFailed:
+				result.Status = util.BatchStatusFailed
+			default:
+				result.Status = util.BatchStatusMined
+			}
+			if err := util.WriteBatchResults(resultsPath, results); err != nil {
+				outputIf(!quiet, fmt.Sprintf("Failed to write batch results: %v", err))
+			}
+		}(result)
+	}
+	wg.Wait()
+}
+
+// outputBatchResults outputs the results of a batch and returns the exit
+// status.
+func outputBatchResults(transfers []*util.BatchTransfer, results map[int]*util.BatchResult) int {
+	lines := make([]int, 0, len(transfers))
+	for _, transfer := range transfers {
+		lines = append(lines, transfer.Line)
+	}
+	sort.Ints(lines)
+
+	status := _exit_success
+	for _, line := range lines {
+		result, exists := results[line]
+		if !exists {
+			continue
+		}
+		switch result.Status {
+		case util.BatchStatusError, util.BatchStatusFailed:
+			status = _exit_failure
+		case util.BatchStatusNotMined:
+			if status == _exit_success {
+				status = _exit_not_mined
+			}
+		}
+		if result.Error != "" {
+			outputIf(!quiet, fmt.Sprintf("%d\t%s\t%s (%s)", line, result.Hash, result.Status, result.Error))
+		} else {
+			outputIf(!quiet, fmt.Sprintf("%d\t%s\t%s", line, result.Hash, result.Status))
+		}
+	}
+	return status
+}
+
+// Add flags for commands that support batches of transfers
+func addBatchFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&batchFile, "batch", "", "CSV file of transfers (to,amount[,data]) to send in place of a single transfer")
+	cmd.Flags().StringVar(&batchResultsFile, "batchresults", "", "CSV file in which to record the results of the batch, used to resume it (default <batch>.results)")
+	cmd.Flags().IntVar(&batchConcurrency, "batchconcurrency", 4, "maximum number of batch transactions to send or wait for at a time")
+}
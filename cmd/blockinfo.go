@@ -21,10 +21,12 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/spf13/cobra"
 	"github.com/wealdtech/ethereal/cli"
-	ens "github.com/wealdtech/go-ens/v2"
+	"github.com/wealdtech/ethereal/util/output"
+	ens "github.com/wealdtech/go-ens/v3"
 )
 
 var blockInfoTransactions bool
@@ -61,6 +63,11 @@ In quiet mode this will return 0 if the block exists, otherwise 1.`,
 			os.Exit(_exit_success)
 		}
 
+		if structuredOutput() {
+			outputResult(blockInfoResult(block))
+			os.Exit(_exit_success)
+		}
+
 		fmt.Printf("Number:\t\t\t%v\n", block.Number())
 		fmt.Printf("Hash:\t\t\t%v\n", block.Hash().Hex())
 		fmt.Printf("Block time:\t\t%v (%v)\n", block.Time(), time.Unix(int64(block.Time()), 0))
@@ -97,6 +104,33 @@ In quiet mode this will return 0 if the block exists, otherwise 1.`,
 	},
 }
 
+// blockInfoResult creates the structured result for a block.
+func blockInfoResult(block *types.Block) *output.BlockInfo {
+	result := &output.BlockInfo{
+		Number:       block.NumberU64(),
+		Hash:         block.Hash().Hex(),
+		ParentHash:   block.ParentHash().Hex(),
+		Timestamp:    block.Time(),
+		Miner:        block.Coinbase().Hex(),
+		Extra:        hexutil.Encode(block.Extra()),
+		Difficulty:   block.Difficulty().String(),
+		GasLimit:     block.GasLimit(),
+		GasUsed:      block.GasUsed(),
+		Uncles:       make([]string, len(block.Uncles())),
+		Transactions: make([]string, block.Transactions().Len()),
+	}
+	if block.BaseFee() != nil {
+		result.BaseFeePerGas = block.BaseFee().String()
+	}
+	for i, uncle := range block.Uncles() {
+		result.Uncles[i] = uncle.Hash().Hex()
+	}
+	for i, tx := range block.Transactions() {
+		result.Transactions[i] = tx.Hash().Hex()
+	}
+	return result
+}
+
 func init() {
 	blockCmd.AddCommand(blockInfoCmd)
 	blockInfoCmd.Flags().BoolVar(&blockInfoTransactions, "transactions", false, "Display hashes of all block transactions")
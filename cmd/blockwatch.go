@@ -0,0 +1,102 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/wealdtech/ethereal/cli"
+	"github.com/wealdtech/ethereal/util"
+)
+
+// blockWatchCmd represents the block watch command
+var blockWatchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Watch for new blocks",
+	Long: `Watch for new blocks as they are produced.  For example:
+
+   ethereal block watch --confirmations=3
+
+Blocks are watched from the block given by --fromblock, or from the next block if not supplied, and a block is processed once it has --confirmations confirmations.
+
+Blocks are output one per line, or in the format given by --output.  Alternatively --exec supplies a command that is run for each block.  The command is supplied with the block information as JSON on its standard input, and with the following environment variables:
+
+  - ETHEREAL_BLOCK_NUMBER: the number of the block
+  - ETHEREAL_BLOCK_HASH: the hash of the block
+  - ETHEREAL_BLOCK_TIMESTAMP: the timestamp of the block, in seconds since the Unix epoch
+  - ETHEREAL_BLOCK_TRANSACTIONS: the number of transactions in the block
+
+If the command fails the watch stops.
+
+--checkpoint supplies a file in which progress is recorded.  If the watch is restarted with the same file it will resume with the first block that has not been processed.
+
+This command runs until it is interrupted.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli.Assert(!offline, quiet, "Offline mode not supported at current with this command")
+
+		checkpoint := watchStart()
+		ctx := watchContext()
+
+		watchRun(ctx, checkpoint.Block, func(from uint64, to uint64) error {
+			for number := from; number <= to; number++ {
+				blockCtx, cancel := util.RequestContext(ctx, viper.GetDuration("timeout"))
+				block, err := client.BlockByNumber(blockCtx, new(big.Int).SetUint64(number))
+				cancel()
+				if err != nil {
+					return fmt.Errorf("failed to obtain block %d: %v", number, err)
+				}
+				if err := blockWatchProcess(ctx, block); err != nil {
+					return err
+				}
+				if err := watchSaveCheckpoint(&util.Checkpoint{Block: number + 1}); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	},
+}
+
+// blockWatchProcess processes a single block.
+func blockWatchProcess(ctx context.Context, block *types.Block) error {
+	switch {
+	case watchExec != "":
+		env := []string{
+			fmt.Sprintf("ETHEREAL_BLOCK_NUMBER=%d", block.NumberU64()),
+			fmt.Sprintf("ETHEREAL_BLOCK_HASH=%s", block.Hash().Hex()),
+			fmt.Sprintf("ETHEREAL_BLOCK_TIMESTAMP=%d", block.Time()),
+			fmt.Sprintf("ETHEREAL_BLOCK_TRANSACTIONS=%d", len(block.Transactions())),
+		}
+		if err := watchRunHook(ctx, env, blockInfoResult(block)); err != nil {
+			return fmt.Errorf("block %d: %v", block.NumberU64(), err)
+		}
+	case quiet:
+	case structuredOutput():
+		outputResult(blockInfoResult(block))
+	default:
+		fmt.Printf("%d\t%s\t%s\t%d\n", block.NumberU64(), block.Hash().Hex(), time.Unix(int64(block.Time()), 0).Format(time.RFC3339), len(block.Transactions()))
+	}
+	return nil
+}
+
+func init() {
+	blockCmd.AddCommand(blockWatchCmd)
+	addWatchFlags(blockWatchCmd)
+}
@@ -28,7 +28,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/wealdtech/ethereal/cli"
 	"github.com/wealdtech/ethereal/util"
-	ens "github.com/wealdtech/go-ens/v2"
+	ens "github.com/wealdtech/go-ens/v3"
 )
 
 var contractStr string
@@ -97,7 +97,7 @@ func parseContract(binStr string) *util.Contract {
 func contractParseAbi(input string) (output abi.ABI, err error) {
 	var reader io.Reader
 
-	if strings.HasPrefix(contractAbi, "[") {
+	if strings.HasPrefix(input, "[") {
 		// ABI is direct
 		reader = strings.NewReader(input)
 	} else {
@@ -131,7 +131,7 @@ func contractParseFunction(input string) (*abi.ABI, error) {
 			argName = argBits[len(argBits)-1]
 		}
 		argType = intFixRe.ReplaceAllString(argType, `${1}256${2}`)
-		t, err := abi.NewType(argType, nil)
+		t, err := abi.NewType(argType, "", nil)
 		if err != nil {
 			return nil, err
 		}
@@ -148,7 +148,7 @@ func contractParseFunction(input string) (*abi.ABI, error) {
 		for i, outputType := range outputTypes {
 			outputType = strings.Split(outputType, " ")[0]
 			outputType = intFixRe.ReplaceAllString(outputType, `${1}256${2}`)
-			t, err := abi.NewType(outputType, nil)
+			t, err := abi.NewType(outputType, "", nil)
 			if err != nil {
 				return nil, err
 			}
@@ -22,7 +22,8 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/wealdtech/ethereal/cli"
 	"github.com/wealdtech/ethereal/util/funcparser"
-	ens "github.com/wealdtech/go-ens/v2"
+	"github.com/wealdtech/ethereal/util/output"
+	ens "github.com/wealdtech/go-ens/v3"
 )
 
 var contractCallFromAddress string
@@ -82,17 +83,26 @@ In quiet mode this will return 0 if the contract is successfully called, otherwi
 
 		outputIf(verbose, fmt.Sprintf("Result is %x", []byte(result)))
 
-		var tmp interface{}
-		err = contract.Abi.Unpack(&tmp, method.Name, result)
-		cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to parse output of %s: %v", method.Name, err))
+		outputs, err := contract.Abi.Unpack(method.Name, result)
+		cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to parse output of %s", method.Name))
 
-		outputs := make([]interface{}, len(method.Outputs))
-		if len(method.Outputs) == 1 {
-			outputs[0] = tmp
-		} else {
-			for i, x := range tmp.([]interface{}) {
-				outputs[i] = x
+		if structuredOutput() {
+			result := &output.ContractCall{
+				Contract: contractAddress.Hex(),
+				Method:   method.Name,
+				Outputs:  make([]*output.ContractCallOutput, len(outputs)),
 			}
+			for i := range outputs {
+				val, err := contractValueToString(method.Outputs[i].Type, outputs[i])
+				cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to turn value %v in to suitable output", outputs[i]))
+				result.Outputs[i] = &output.ContractCallOutput{
+					Name:  method.Outputs[i].Name,
+					Type:  method.Outputs[i].Type.String(),
+					Value: val,
+				}
+			}
+			outputResult(result)
+			os.Exit(_exit_success)
 		}
 
 		results := []string{}
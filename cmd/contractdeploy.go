@@ -14,7 +14,6 @@
 package cmd
 
 import (
-	"bytes"
 	"encoding/hex"
 	"fmt"
 	"math/big"
@@ -25,7 +24,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/wealdtech/ethereal/cli"
 	"github.com/wealdtech/ethereal/util/funcparser"
-	ens "github.com/wealdtech/go-ens/v2"
+	ens "github.com/wealdtech/go-ens/v3"
 	string2eth "github.com/wealdtech/go-string2eth"
 )
 
@@ -86,9 +85,9 @@ This will return an exit status of 0 if the transaction is successfully submitte
 
 			if offline {
 				if !quiet {
-					buf := new(bytes.Buffer)
-					signedTx.EncodeRLP(buf)
-					fmt.Printf("0x%s\n", hex.EncodeToString(buf.Bytes()))
+					data, err := signedTx.MarshalBinary()
+					cli.ErrCheck(err, quiet, "Failed to marshal transaction")
+					fmt.Printf("0x%s\n", hex.EncodeToString(data))
 				}
 				os.Exit(_exit_success)
 			}
@@ -118,4 +117,5 @@ func init() {
 	contractDeployCmd.Flags().StringVar(&contractDeployFromAddress, "from", "", "Address from which to deploy the contract")
 	contractDeployCmd.Flags().IntVar(&contractDeployRepeat, "repeat", 1, "Number of times to repeat sending the transaction (incrementing the nonce each time)")
 	addTransactionFlags(contractDeployCmd, "Passphrase for the address from which to deploy the conract")
+	addAccessListFlag(contractDeployCmd)
 }
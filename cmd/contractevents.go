@@ -0,0 +1,475 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/wealdtech/ethereal/cli"
+	"github.com/wealdtech/ethereal/util"
+	"github.com/wealdtech/ethereal/util/output"
+	"github.com/wealdtech/ethereal/util/txdata"
+	ens "github.com/wealdtech/go-ens/v3"
+)
+
+var contractEventsEvent string
+var contractEventsTopics string
+var contractEventsFromBlock string
+var contractEventsToBlock string
+var contractEventsChunkSize uint64
+var contractEventsCSV bool
+
+// contractEventsCmd represents the contract events command
+var contractEventsCmd = &cobra.Command{
+	Use:   "events",
+	Short: "Obtain events emitted by a contract",
+	Long: `Obtain events emitted by a contract over a range of blocks.  For example:
+
+   ethereal contract events --contract=0xd26114cd6EE289AccF82350c8d8487fedB8A0C07 --event="Transfer(address indexed from, address indexed to, uint256 value)" --topics=,@wealdtech.eth --fromblock=15000000
+
+The event can be supplied as a signature with --event, or the events can be taken from the contract's ABI supplied with --abi or --json, in which case --event can be the name of a single event to obtain.  If no event is supplied all events emitted by the contract are obtained, and those that are not in the ABI are decoded where possible.
+
+--topics filters on the indexed arguments of the event, in order.  An empty value matches any value, and alternative values are separated by '|'.  For example --topics=@alice.eth|@bob.eth matches events where the first indexed argument is either alice.eth or bob.eth.
+
+Events are obtained in chunks of up to --chunksize blocks; if the node complains that a request is too large the chunk is reduced in size and the request retried, and if the node rate limits a request it is retried after a delay.
+
+Events are output one per line.  Alternatively they can be output as CSV with --csv, or in the format given by --output.
+
+In quiet mode this will return 0 if any events are obtained, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli.Assert(!offline, quiet, "Offline mode not supported at current with this command")
+
+		query, events, selected := contractEventsFilter(contractEventsEvent, contractEventsTopics)
+
+		fromBlock, err := contractEventsBlock(contractEventsFromBlock)
+		cli.ErrCheck(err, quiet, fmt.Sprintf("Invalid from block %s", contractEventsFromBlock))
+		toBlock, err := contractEventsBlock(contractEventsToBlock)
+		cli.ErrCheck(err, quiet, fmt.Sprintf("Invalid to block %s", contractEventsToBlock))
+		outputIf(verbose, fmt.Sprintf("Obtaining events from block %d to block %d", fromBlock, toBlock))
+
+		logs, err := util.FetchLogs(context.Background(), client, query, fromBlock, toBlock, contractEventsChunkSize, viper.GetDuration("timeout"))
+		cli.ErrCheck(err, quiet, "Failed to obtain events")
+
+		results := make([]*output.ContractEvent, len(logs))
+		for i := range logs {
+			results[i] = contractEventResult(&logs[i], events)
+		}
+
+		switch {
+		case quiet:
+		case structuredOutput():
+			outputResult(&output.ContractEvents{Events: results})
+		case contractEventsCSV:
+			err = contractEventsWriteCSV(results, selected)
+			cli.ErrCheck(err, quiet, "Failed to write CSV")
+		default:
+			for i, result := range results {
+				fmt.Println(contractEventLine(result, &logs[i]))
+			}
+		}
+
+		if len(results) == 0 {
+			os.Exit(_exit_failure)
+		}
+		os.Exit(_exit_success)
+	},
+}
+
+// contractEventsFilter creates the filter for events given the contract flags
+// and the user-supplied event and topics.  It also returns the events that can
+// be decoded, and the event selected by the user if any.
+func contractEventsFilter(eventStr string, topicsStr string) (ethereum.FilterQuery, map[common.Hash]*abi.Event, *abi.Event) {
+	var address *common.Address
+	if contractStr != "" {
+		contractAddress, err := ens.Resolve(client, contractStr)
+		cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to resolve contract address %s", contractStr))
+		address = &contractAddress
+	}
+
+	// Work out the events that we know about, and the event that we want.
+	events := make(map[common.Hash]*abi.Event)
+	if contractAbi != "" || contractJSON != "" {
+		contract := parseContract("")
+		for name := range contract.Abi.Events {
+			event := contract.Abi.Events[name]
+			events[event.ID] = &event
+		}
+	}
+	var selected *abi.Event
+	if eventStr != "" {
+		if strings.Contains(eventStr, "(") {
+			selected, err = contractParseEvent(eventStr)
+			cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to parse event %s", eventStr))
+			events[selected.ID] = selected
+		} else {
+			for _, event := range events {
+				if event.Name == eventStr {
+					selected = event
+				}
+			}
+			cli.Assert(selected != nil, quiet, fmt.Sprintf("Unknown event %s", eventStr))
+		}
+		outputIf(verbose, fmt.Sprintf("Event signature is %s (%s)", selected.Sig, selected.ID.Hex()))
+	}
+	cli.Assert(address != nil || selected != nil, quiet, "--contract or --event is required")
+
+	query := ethereum.FilterQuery{}
+	if address != nil {
+		query.Addresses = []common.Address{*address}
+	}
+	topics, err := contractEventsTopicFilters(selected, topicsStr)
+	cli.ErrCheck(err, quiet, "Invalid topics")
+	if selected != nil {
+		query.Topics = append([][]common.Hash{{selected.ID}}, topics...)
+	} else if len(topics) > 0 {
+		query.Topics = append([][]common.Hash{nil}, topics...)
+	}
+
+	return query, events, selected
+}
+
+// contractParseEvent parses an event signature, for example
+// 'Transfer(address indexed from, address indexed to, uint256 value)'.
+func contractParseEvent(input string) (*abi.Event, error) {
+	input = strings.TrimSpace(input)
+	bits := strings.SplitN(input, "(", 2)
+	if len(bits) != 2 || !strings.HasSuffix(bits[1], ")") {
+		return nil, fmt.Errorf("invalid event signature %s", input)
+	}
+	name := strings.TrimSpace(bits[0])
+	inputs := make(abi.Arguments, 0)
+	argsStr := strings.TrimSuffix(bits[1], ")")
+	if strings.TrimSpace(argsStr) != "" {
+		for _, argStr := range strings.Split(argsStr, ",") {
+			argBits := strings.Fields(argStr)
+			if len(argBits) == 0 {
+				return nil, fmt.Errorf("invalid event signature %s", input)
+			}
+			argType := intFixRe.ReplaceAllString(argBits[0], `${1}256${2}`)
+			t, err := abi.NewType(argType, "", nil)
+			if err != nil {
+				return nil, err
+			}
+			arg := abi.Argument{Type: t}
+			for _, argBit := range argBits[1:] {
+				if argBit == "indexed" {
+					arg.Indexed = true
+				} else {
+					arg.Name = argBit
+				}
+			}
+			inputs = append(inputs, arg)
+		}
+	}
+	event := abi.NewEvent(name, name, false, inputs)
+	return &event, nil
+}
+
+// contractEventsBlock obtains a block number given a string.
+func contractEventsBlock(input string) (uint64, error) {
+	if input == "latest" {
+		ctx, cancel := localContext()
+		defer cancel()
+		return client.BlockNumber(ctx)
+	}
+	return strconv.ParseUint(input, 10, 64)
+}
+
+// contractEventsTopicFilters creates topic filters for the indexed arguments of
+// an event from the user-supplied values.
+func contractEventsTopicFilters(event *abi.Event, input string) ([][]common.Hash, error) {
+	if input == "" {
+		return nil, nil
+	}
+	values := strings.Split(input, ",")
+	var indexed abi.Arguments
+	if event != nil {
+		for _, arg := range event.Inputs {
+			if arg.Indexed {
+				indexed = append(indexed, arg)
+			}
+		}
+		if len(values) > len(indexed) {
+			return nil, fmt.Errorf("event %s has %d indexed arguments", event.Name, len(indexed))
+		}
+	} else if len(values) > 3 {
+		return nil, errors.New("events have at most 3 indexed arguments")
+	}
+
+	topics := make([][]common.Hash, len(values))
+	for i, value := range values {
+		if strings.TrimSpace(value) == "" {
+			// Matches anything.
+			continue
+		}
+		var argType *abi.Type
+		if event != nil {
+			argType = &indexed[i].Type
+		}
+		for _, alternative := range strings.Split(value, "|") {
+			topic, err := contractEventsTopic(argType, strings.TrimSpace(alternative))
+			if err != nil {
+				return nil, err
+			}
+			topics[i] = append(topics[i], topic)
+		}
+	}
+	return topics, nil
+}
+
+// contractEventsTopic turns a value in to a topic.  If the type is not known
+// the value must be an address or a 32-byte hex string.
+func contractEventsTopic(argType *abi.Type, value string) (common.Hash, error) {
+	if argType == nil {
+		if len(value) == 66 && strings.HasPrefix(value, "0x") {
+			return common.HexToHash(value), nil
+		}
+		address, err := ens.Resolve(client, value)
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("failed to resolve %s", value)
+		}
+		return common.BytesToHash(address.Bytes()), nil
+	}
+
+	switch argType.T {
+	case abi.AddressTy:
+		address, err := ens.Resolve(client, value)
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("failed to resolve %s", value)
+		}
+		return common.BytesToHash(address.Bytes()), nil
+	case abi.IntTy, abi.UintTy:
+		val, success := new(big.Int).SetString(value, 0)
+		if !success {
+			return common.Hash{}, fmt.Errorf("invalid integer %s", value)
+		}
+		return common.BigToHash(math.U256(val)), nil
+	case abi.BoolTy:
+		switch value {
+		case "true":
+			return common.BigToHash(big.NewInt(1)), nil
+		case "false":
+			return common.Hash{}, nil
+		default:
+			return common.Hash{}, fmt.Errorf("invalid boolean %s", value)
+		}
+	case abi.FixedBytesTy, abi.HashTy:
+		data, err := hex.DecodeString(strings.TrimPrefix(value, "0x"))
+		if err != nil || len(data) > common.HashLength {
+			return common.Hash{}, fmt.Errorf("invalid bytes %s", value)
+		}
+		return common.BytesToHash(common.RightPadBytes(data, common.HashLength)), nil
+	case abi.StringTy:
+		// Indexed dynamic values are stored as their hash.
+		return crypto.Keccak256Hash([]byte(value)), nil
+	case abi.BytesTy:
+		data, err := hex.DecodeString(strings.TrimPrefix(value, "0x"))
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("invalid bytes %s", value)
+		}
+		return crypto.Keccak256Hash(data), nil
+	default:
+		if len(value) == 66 && strings.HasPrefix(value, "0x") {
+			return common.HexToHash(value), nil
+		}
+		return common.Hash{}, fmt.Errorf("cannot filter on value of type %v; supply the 32-byte topic", argType)
+	}
+}
+
+// contractEventResult creates the result for a log, decoding it if the event
+// is known.
+func contractEventResult(log *types.Log, events map[common.Hash]*abi.Event) *output.ContractEvent {
+	result := &output.ContractEvent{
+		BlockNumber:     log.BlockNumber,
+		TransactionHash: log.TxHash.Hex(),
+		LogIndex:        log.Index,
+		Address:         log.Address.Hex(),
+		Topics:          make([]string, len(log.Topics)),
+		Data:            hexutil.Encode(log.Data),
+	}
+	for i := range log.Topics {
+		result.Topics[i] = log.Topics[i].Hex()
+	}
+	if len(log.Topics) == 0 {
+		return result
+	}
+	event, exists := events[log.Topics[0]]
+	if !exists {
+		return result
+	}
+	args, err := contractEventArgs(event, log)
+	if err != nil {
+		outputIf(debug, fmt.Sprintf("Failed to decode %s event in %s: %v", event.Name, result.TransactionHash, err))
+		return result
+	}
+	result.Name = event.Name
+	result.Args = args
+	return result
+}
+
+// contractEventArgs decodes the arguments of an event.
+func contractEventArgs(event *abi.Event, log *types.Log) ([]*output.ContractEventArg, error) {
+	values, err := event.Inputs.Unpack(log.Data)
+	if err != nil {
+		return nil, err
+	}
+	topics := log.Topics[1:]
+	args := make([]*output.ContractEventArg, len(event.Inputs))
+	topic := 0
+	value := 0
+	for i, input := range event.Inputs {
+		var val string
+		if input.Indexed {
+			if topic >= len(topics) {
+				return nil, fmt.Errorf("missing topic for %s", input.Name)
+			}
+			val, err = contractTopicToString(input, topics[topic])
+			topic++
+		} else {
+			if value >= len(values) {
+				return nil, fmt.Errorf("missing value for %s", input.Name)
+			}
+			val, err = contractValueToString(input.Type, values[value])
+			value++
+		}
+		if err != nil {
+			return nil, err
+		}
+		args[i] = &output.ContractEventArg{
+			Name:    input.Name,
+			Type:    input.Type.String(),
+			Indexed: input.Indexed,
+			Value:   val,
+		}
+	}
+	return args, nil
+}
+
+// contractTopicToString decodes an indexed argument of an event.
+func contractTopicToString(input abi.Argument, topic common.Hash) (string, error) {
+	switch input.Type.T {
+	case abi.StringTy, abi.BytesTy, abi.SliceTy, abi.ArrayTy, abi.TupleTy:
+		// Indexed dynamic values are stored as their hash so cannot be recovered.
+		return topic.Hex(), nil
+	}
+	values := make(map[string]interface{})
+	if err := abi.ParseTopicsIntoMap(values, abi.Arguments{input}, []common.Hash{topic}); err != nil {
+		return "", err
+	}
+	return contractValueToString(input.Type, values[input.Name])
+}
+
+// contractEventString returns a decoded event as a string, or an empty string
+// if the event could not be decoded.
+func contractEventString(result *output.ContractEvent) string {
+	if result.Name == "" {
+		return ""
+	}
+	values := make([]string, len(result.Args))
+	for i, arg := range result.Args {
+		values[i] = arg.Value
+	}
+	return fmt.Sprintf("%s(%s)", result.Name, strings.Join(values, ","))
+}
+
+// contractEventLine returns the line of text output for an event.
+func contractEventLine(result *output.ContractEvent, log *types.Log) string {
+	decoded := contractEventString(result)
+	if decoded == "" {
+		decoded = txdata.EventToString(client, log)
+	}
+	if decoded == "" {
+		decoded = fmt.Sprintf("%v", result.Topics)
+	}
+	if verbose {
+		return fmt.Sprintf("%d\t%s\t%d\t%s\t%s", result.BlockNumber, result.TransactionHash, result.LogIndex, result.Address, decoded)
+	}
+	return fmt.Sprintf("%d\t%s\t%s", result.BlockNumber, result.TransactionHash, decoded)
+}
+
+// contractEventsWriteCSV writes events as CSV.  If a single event was
+// requested each of its arguments has its own column, otherwise the arguments
+// are combined in a single column.
+func contractEventsWriteCSV(results []*output.ContractEvent, selected *abi.Event) error {
+	writer := csv.NewWriter(os.Stdout)
+	header := []string{"blockNumber", "transactionHash", "logIndex", "address", "name"}
+	if selected != nil {
+		for _, input := range selected.Inputs {
+			header = append(header, input.Name)
+		}
+	} else {
+		header = append(header, "args")
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for _, result := range results {
+		record := []string{
+			fmt.Sprintf("%d", result.BlockNumber),
+			result.TransactionHash,
+			fmt.Sprintf("%d", result.LogIndex),
+			result.Address,
+			result.Name,
+		}
+		if selected != nil {
+			for i := range selected.Inputs {
+				value := ""
+				if i < len(result.Args) {
+					value = result.Args[i].Value
+				}
+				record = append(record, value)
+			}
+		} else {
+			args := make([]string, len(result.Args))
+			for i, arg := range result.Args {
+				args[i] = fmt.Sprintf("%s=%s", arg.Name, arg.Value)
+			}
+			record = append(record, strings.Join(args, ";"))
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func init() {
+	contractCmd.AddCommand(contractEventsCmd)
+	contractFlags(contractEventsCmd)
+	contractEventsCmd.Flags().StringVar(&contractEventsEvent, "event", "", "Signature of the event, or its name if an ABI is supplied")
+	contractEventsCmd.Flags().StringVar(&contractEventsTopics, "topics", "", "Comma-separated values of indexed arguments on which to filter")
+	contractEventsCmd.Flags().StringVar(&contractEventsFromBlock, "fromblock", "0", "Block from which to obtain events")
+	contractEventsCmd.Flags().StringVar(&contractEventsToBlock, "toblock", "latest", "Block up to which to obtain events")
+	contractEventsCmd.Flags().Uint64Var(&contractEventsChunkSize, "chunksize", 10000, "Maximum number of blocks to request in a single request")
+	contractEventsCmd.Flags().BoolVar(&contractEventsCSV, "csv", false, "Output the events as CSV")
+}
@@ -0,0 +1,202 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+	"github.com/wealdtech/ethereal/cli"
+	"github.com/wealdtech/ethereal/util"
+	"github.com/wealdtech/ethereal/util/contracts"
+	"github.com/wealdtech/ethereal/util/funcparser"
+	"github.com/wealdtech/ethereal/util/txdata"
+	ens "github.com/wealdtech/go-ens/v3"
+)
+
+var contractMulticallFromAddress string
+var contractMulticallCalls string
+
+// multicallEntry is a single call in a multicall file.  Contract, ABI and
+// function default to the values supplied on the command line.
+type multicallEntry struct {
+	Contract string `json:"contract"`
+	Abi      string `json:"abi"`
+	Function string `json:"function"`
+	Call     string `json:"call"`
+}
+
+// contractMulticallCmd represents the contract multicall command
+var contractMulticallCmd = &cobra.Command{
+	Use:   "multicall",
+	Short: "Call multiple contract methods at once",
+	Long: `Call multiple contract methods, which can be on different contracts, in a single call to the node.  For example:
+
+   ethereal contract multicall --calls=./calls.json
+
+where calls.json contains a list of calls in the same form as 'contract call', for example:
+
+   [
+     {"contract":"0xd26114cd6EE289AccF82350c8d8487fedB8A0C07","abi":"./erc20.abi","call":"totalSupply()"},
+     {"contract":"0xd26114cd6EE289AccF82350c8d8487fedB8A0C07","function":"balanceOf(address) returns (uint256)","call":"balanceOf(@wealdtech.eth)"}
+   ]
+
+Any of contract, abi and function that are not supplied for a call are taken from --contract, --abi and --function respectively.  The calls are made through the Multicall3 contract if it is present on the chain, otherwise individually.  The results are output one line per call.
+
+In quiet mode this will return 0 if all of the calls succeed, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli.Assert(!offline, quiet, "Offline mode not supported at current with this command")
+		var fromAddress common.Address
+		if contractMulticallFromAddress != "" {
+			fromAddress, err = ens.Resolve(client, contractMulticallFromAddress)
+			cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to resolve from address %s", contractMulticallFromAddress))
+		}
+
+		cli.Assert(contractMulticallCalls != "", quiet, "--calls is required")
+		var data []byte
+		if strings.HasPrefix(strings.TrimSpace(contractMulticallCalls), "[") {
+			data = []byte(contractMulticallCalls)
+		} else {
+			data, err = ioutil.ReadFile(contractMulticallCalls)
+			cli.ErrCheck(err, quiet, "Failed to read calls")
+		}
+		entries := make([]*multicallEntry, 0)
+		err = json.Unmarshal(data, &entries)
+		cli.ErrCheck(err, quiet, "Failed to parse calls")
+		cli.Assert(len(entries) > 0, quiet, "No calls supplied")
+
+		// Encode the calls
+		calls := make([]contracts.Multicall3Call3, len(entries))
+		methods := make([]*abi.Method, len(entries))
+		abis := make([]*abi.ABI, len(entries))
+		contractCache := make(map[string]*util.Contract)
+		for i, entry := range entries {
+			cli.Assert(entry.Call != "", quiet, fmt.Sprintf("Call %d: call is required", i))
+			if entry.Contract == "" {
+				entry.Contract = contractStr
+			}
+			cli.Assert(entry.Contract != "", quiet, fmt.Sprintf("Call %d: contract is required", i))
+			address, err := ens.Resolve(client, entry.Contract)
+			cli.ErrCheck(err, quiet, fmt.Sprintf("Call %d: failed to resolve contract address %s", i, entry.Contract))
+
+			contract, err := multicallContract(entry, contractCache)
+			cli.ErrCheck(err, quiet, fmt.Sprintf("Call %d: failed to obtain ABI", i))
+			method, methodArgs, err := funcparser.ParseCall(client, contract, entry.Call)
+			cli.ErrCheck(err, quiet, fmt.Sprintf("Call %d: failed to parse call", i))
+			callData, err := contract.Abi.Pack(method.Name, methodArgs...)
+			cli.ErrCheck(err, quiet, fmt.Sprintf("Call %d: failed to convert arguments", i))
+			outputIf(debug, fmt.Sprintf("Call %d data is %x", i, callData))
+
+			calls[i] = contracts.Multicall3Call3{
+				Target:       address,
+				AllowFailure: true,
+				CallData:     callData,
+			}
+			methods[i] = method
+			abis[i] = &contract.Abi
+		}
+
+		ctx, cancel := localContext()
+		defer cancel()
+		results, err := util.Multicall(ctx, client, fromAddress, calls)
+		cli.ErrCheck(err, quiet, "Failed to make calls")
+
+		failed := false
+		for i, result := range results {
+			prefix := ""
+			if verbose {
+				prefix = fmt.Sprintf("%s: ", entries[i].Call)
+			}
+			if !result.Success {
+				failed = true
+				outputIf(!quiet, fmt.Sprintf("%sfailed: %s", prefix, txdata.RevertToString(client, abis[i], result.ReturnData)))
+				continue
+			}
+			output, err := multicallOutput(methods[i], result.ReturnData)
+			if err != nil {
+				failed = true
+				outputIf(!quiet, fmt.Sprintf("%sfailed: %v", prefix, err))
+				continue
+			}
+			outputIf(!quiet, prefix+output)
+		}
+		if failed {
+			os.Exit(_exit_failure)
+		}
+		os.Exit(_exit_success)
+	},
+}
+
+// multicallContract obtains the contract definition for a multicall entry,
+// falling back to that supplied on the command line.
+func multicallContract(entry *multicallEntry, cache map[string]*util.Contract) (*util.Contract, error) {
+	key := fmt.Sprintf("%s|%s", entry.Abi, entry.Function)
+	if contract, exists := cache[key]; exists {
+		return contract, nil
+	}
+	var contract *util.Contract
+	switch {
+	case entry.Abi != "":
+		contractAbi, err := contractParseAbi(entry.Abi)
+		if err != nil {
+			return nil, err
+		}
+		contract = &util.Contract{Abi: contractAbi}
+	case entry.Function != "":
+		contractAbi, err := contractParseFunction(entry.Function)
+		if err != nil {
+			return nil, err
+		}
+		contract = &util.Contract{Abi: *contractAbi}
+	default:
+		contract = parseContract("")
+	}
+	cache[key] = contract
+	return contract, nil
+}
+
+// multicallOutput decodes the output of a method to a string.
+func multicallOutput(method *abi.Method, data []byte) (string, error) {
+	if len(method.Outputs) == 0 {
+		return "", nil
+	}
+	if len(data) == 0 {
+		return "", fmt.Errorf("call to %s did not return expected data", method.Name)
+	}
+	outputs, err := method.Outputs.Unpack(data)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse output of %s: %v", method.Name, err)
+	}
+	results := make([]string, len(outputs))
+	for i := range outputs {
+		results[i], err = contractValueToString(method.Outputs[i].Type, outputs[i])
+		if err != nil {
+			return "", fmt.Errorf("failed to turn value %v in to suitable output: %v", outputs[i], err)
+		}
+	}
+	return strings.Join(results, ","), nil
+}
+
+func init() {
+	contractCmd.AddCommand(contractMulticallCmd)
+	contractFlags(contractMulticallCmd)
+	contractMulticallCmd.Flags().StringVar(&contractMulticallFromAddress, "from", "", "Address from which to call the contract methods")
+	contractMulticallCmd.Flags().StringVar(&contractMulticallCalls, "calls", "", "JSON, or path to JSON, containing the calls to make")
+}
@@ -14,17 +14,18 @@
 package cmd
 
 import (
-	"bytes"
 	"encoding/hex"
 	"fmt"
 	"math/big"
 	"os"
 
+	ethereum "github.com/ethereum/go-ethereum"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/wealdtech/ethereal/cli"
 	"github.com/wealdtech/ethereal/util/funcparser"
-	ens "github.com/wealdtech/go-ens/v2"
+	"github.com/wealdtech/ethereal/util/txdata"
+	ens "github.com/wealdtech/go-ens/v3"
 	string2eth "github.com/wealdtech/go-string2eth"
 )
 
@@ -71,15 +72,34 @@ This will return an exit status of 0 if the transaction is successfully submitte
 			cli.ErrCheck(err, quiet, fmt.Sprintf("Invalid amount %s", contractSendAmount))
 		}
 
+		// Ensure that the transaction will not revert
+		if client != nil {
+			txdata.InitFunctionMap()
+			reverted, reason, err := callRevertReason(ethereum.CallMsg{
+				From:       fromAddress,
+				To:         &contractAddress,
+				Value:      amount,
+				Data:       data,
+				AccessList: accessList,
+			}, nil, &contract.Abi)
+			cli.ErrCheck(err, quiet, "Failed to check contract method transaction")
+			if reverted {
+				if reason == "" {
+					reason = "no reason given"
+				}
+				cli.Err(quiet, fmt.Sprintf("Contract method transaction would revert: %s", reason))
+			}
+		}
+
 		// Create and sign the transaction
 		signedTx, err := createSignedTransaction(fromAddress, &contractAddress, amount, gasLimit, data)
 		cli.ErrCheck(err, quiet, "Failed to create contract method transaction")
 
 		if offline {
 			if !quiet {
-				buf := new(bytes.Buffer)
-				signedTx.EncodeRLP(buf)
-				fmt.Printf("0x%s\n", hex.EncodeToString(buf.Bytes()))
+				data, err := signedTx.MarshalBinary()
+				cli.ErrCheck(err, quiet, "Failed to marshal transaction")
+				fmt.Printf("0x%s\n", hex.EncodeToString(data))
 			}
 			os.Exit(_exit_success)
 		}
@@ -104,4 +124,5 @@ func init() {
 	contractSendCmd.Flags().StringVar(&contractSendCall, "call", "", "Contract function to call")
 	contractSendCmd.Flags().StringVar(&contractSendReturns, "returns", "", "Comma-separated return types")
 	addTransactionFlags(contractSendCmd, "Passphrase for the address from which to send the contract transaction")
+	addAccessListFlag(contractSendCmd)
 }
@@ -0,0 +1,129 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/wealdtech/ethereal/cli"
+	"github.com/wealdtech/ethereal/util"
+	"github.com/wealdtech/ethereal/util/output"
+)
+
+var contractWatchEvent string
+var contractWatchTopics string
+var contractWatchChunkSize uint64
+
+// contractWatchEnvRe matches characters that cannot be in environment variable names.
+var contractWatchEnvRe = regexp.MustCompile(`[^A-Z0-9_]`)
+
+// contractWatchCmd represents the contract watch command
+var contractWatchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Watch for events emitted by a contract",
+	Long: `Watch for events emitted by a contract as they occur.  For example:
+
+   ethereal contract watch --contract=0xd26114cd6EE289AccF82350c8d8487fedB8A0C07 --event="Transfer(address indexed from, address indexed to, uint256 value)" --topics=,@wealdtech.eth
+
+The event and topics are supplied as for 'contract events'.  Events are watched from the block given by --fromblock, or from the next block if not supplied, and a block is processed once it has --confirmations confirmations.
+
+Events are output one per line, or in the format given by --output.  Alternatively --exec supplies a command that is run for each event.  The command is supplied with the event as JSON on its standard input, and with the following environment variables:
+
+  - ETHEREAL_BLOCK_NUMBER: the number of the block containing the event
+  - ETHEREAL_TX_HASH: the hash of the transaction that emitted the event
+  - ETHEREAL_LOG_INDEX: the index of the event in the block
+  - ETHEREAL_ADDRESS: the address of the contract that emitted the event
+  - ETHEREAL_EVENT: the name of the event, if it could be decoded
+  - ETHEREAL_ARG_<NAME>: the value of each argument of the event, if it could be decoded; an unnamed argument uses its position in the event, starting from 0, in place of its name
+
+If the command fails the watch stops.
+
+--checkpoint supplies a file in which progress is recorded.  If the watch is restarted with the same file it will resume with the first event that has not been processed.
+
+This command runs until it is interrupted.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli.Assert(!offline, quiet, "Offline mode not supported at current with this command")
+
+		query, events, _ := contractEventsFilter(contractWatchEvent, contractWatchTopics)
+		checkpoint := watchStart()
+		ctx := watchContext()
+
+		watchRun(ctx, checkpoint.Block, func(from uint64, to uint64) error {
+			outputIf(debug, fmt.Sprintf("Obtaining events from block %d to block %d", from, to))
+			logs, err := util.FetchLogs(ctx, client, query, from, to, contractWatchChunkSize, viper.GetDuration("timeout"))
+			if err != nil {
+				return err
+			}
+			for i := range logs {
+				if checkpoint.Processed(logs[i].BlockNumber, logs[i].Index) {
+					continue
+				}
+				if err := contractWatchProcess(ctx, &logs[i], contractEventResult(&logs[i], events)); err != nil {
+					return err
+				}
+				checkpoint = &util.Checkpoint{Block: logs[i].BlockNumber, LogIndex: logs[i].Index + 1}
+				if err := watchSaveCheckpoint(checkpoint); err != nil {
+					return err
+				}
+			}
+			checkpoint = &util.Checkpoint{Block: to + 1}
+			return watchSaveCheckpoint(checkpoint)
+		})
+	},
+}
+
+// contractWatchProcess processes a single event.
+func contractWatchProcess(ctx context.Context, log *types.Log, result *output.ContractEvent) error {
+	switch {
+	case watchExec != "":
+		env := []string{
+			fmt.Sprintf("ETHEREAL_BLOCK_NUMBER=%d", result.BlockNumber),
+			fmt.Sprintf("ETHEREAL_TX_HASH=%s", result.TransactionHash),
+			fmt.Sprintf("ETHEREAL_LOG_INDEX=%d", result.LogIndex),
+			fmt.Sprintf("ETHEREAL_ADDRESS=%s", result.Address),
+			fmt.Sprintf("ETHEREAL_EVENT=%s", result.Name),
+		}
+		for i, arg := range result.Args {
+			name := contractWatchEnvRe.ReplaceAllString(strings.ToUpper(arg.Name), "_")
+			if name == "" {
+				name = fmt.Sprintf("%d", i)
+			}
+			env = append(env, fmt.Sprintf("ETHEREAL_ARG_%s=%s", name, arg.Value))
+		}
+		if err := watchRunHook(ctx, env, result); err != nil {
+			return fmt.Errorf("event %d in block %d: %v", result.LogIndex, result.BlockNumber, err)
+		}
+	case quiet:
+	case structuredOutput():
+		outputResult(result)
+	default:
+		fmt.Println(contractEventLine(result, log))
+	}
+	return nil
+}
+
+func init() {
+	contractCmd.AddCommand(contractWatchCmd)
+	contractFlags(contractWatchCmd)
+	addWatchFlags(contractWatchCmd)
+	contractWatchCmd.Flags().StringVar(&contractWatchEvent, "event", "", "Signature of the event, or its name if an ABI is supplied")
+	contractWatchCmd.Flags().StringVar(&contractWatchTopics, "topics", "", "Comma-separated values of indexed arguments on which to filter")
+	contractWatchCmd.Flags().Uint64Var(&contractWatchChunkSize, "chunksize", 10000, "Maximum number of blocks to request in a single request")
+}
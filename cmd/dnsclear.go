@@ -23,7 +23,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/wealdtech/ethereal/cli"
-	ens "github.com/wealdtech/go-ens/v2"
+	ens "github.com/wealdtech/go-ens/v3"
 )
 
 // dnsClearCmd represents the dns clear command
@@ -40,11 +40,13 @@ This will return an exit status of 0 if the transaction is successfully submitte
 		if !strings.HasSuffix(dnsDomain, ".") {
 			dnsDomain = dnsDomain + "."
 		}
-		dnsDomain = ens.NormaliseDomain(dnsDomain)
+		dnsDomain, err = ens.NormaliseDomain(dnsDomain)
+		cli.ErrCheck(err, quiet, "Invalid domain")
 		outputIf(verbose, fmt.Sprintf("DNS domain is %s", dnsDomain))
 		ensDomain := strings.TrimSuffix(dnsDomain, ".")
 		outputIf(verbose, fmt.Sprintf("ENS domain is %s", ensDomain))
-		domainHash := ens.NameHash(ensDomain)
+		domainHash, err := ens.NameHash(ensDomain)
+		cli.ErrCheck(err, quiet, "Failed to obtain name hash")
 		outputIf(verbose, fmt.Sprintf("ENS domain hash is 0x%x", domainHash))
 
 		// Obtain the registry contract
@@ -69,9 +71,9 @@ This will return an exit status of 0 if the transaction is successfully submitte
 		cli.ErrCheck(err, quiet, "Failed to create transaction")
 		if offline {
 			if !quiet {
-				buf := new(bytes.Buffer)
-				signedTx.EncodeRLP(buf)
-				fmt.Printf("0x%s\n", hex.EncodeToString(buf.Bytes()))
+				data, err := signedTx.MarshalBinary()
+				cli.ErrCheck(err, quiet, "Failed to marshal transaction")
+				fmt.Printf("0x%s\n", hex.EncodeToString(data))
 			}
 			os.Exit(_exit_success)
 		}
@@ -22,7 +22,8 @@ import (
 	"github.com/miekg/dns"
 	"github.com/spf13/cobra"
 	"github.com/wealdtech/ethereal/cli"
-	ens "github.com/wealdtech/go-ens/v2"
+	"github.com/wealdtech/ethereal/util/output"
+	ens "github.com/wealdtech/go-ens/v3"
 )
 
 var dnsGetWire bool
@@ -43,7 +44,8 @@ In quiet mode this will return 0 if the resource exists, otherwise 1.`,
 		if !strings.HasSuffix(dnsDomain, ".") {
 			dnsDomain = dnsDomain + "."
 		}
-		dnsDomain = ens.NormaliseDomain(dnsDomain)
+		dnsDomain, err = ens.NormaliseDomain(dnsDomain)
+		cli.ErrCheck(err, quiet, "Invalid domain")
 		outputIf(verbose, fmt.Sprintf("DNS domain is %s", dnsDomain))
 		ensDomain := strings.TrimSuffix(dnsDomain, ".")
 		outputIf(verbose, fmt.Sprintf("ENS domain is %s", ensDomain))
@@ -76,6 +78,26 @@ In quiet mode this will return 0 if the resource exists, otherwise 1.`,
 			os.Exit(_exit_success)
 		}
 
+		if structuredOutput() {
+			result := &output.DNSGet{
+				Domain:   dnsDomain,
+				Name:     dnsName,
+				Resource: dnsResource,
+				Records:  make([]string, 0),
+				Wire:     hex.EncodeToString(data),
+			}
+			offset := 0
+			var rr dns.RR
+			for offset < len(data) {
+				rr, offset, err = dns.UnpackRR(data, offset)
+				if err == nil {
+					result.Records = append(result.Records, rr.String())
+				}
+			}
+			outputResult(result)
+			os.Exit(_exit_success)
+		}
+
 		if dnsGetWire {
 			fmt.Println(hex.EncodeToString(data))
 		} else {
@@ -27,7 +27,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/wealdtech/ethereal/cli"
 	"github.com/wealdtech/ethereal/util"
-	ens "github.com/wealdtech/go-ens/v2"
+	ens "github.com/wealdtech/go-ens/v3"
 )
 
 var dnsSetTTL time.Duration
@@ -48,11 +48,13 @@ In This will return an exit status of 0 if the transaction is successfully submi
 		if !strings.HasSuffix(dnsDomain, ".") {
 			dnsDomain = dnsDomain + "."
 		}
-		dnsDomain = ens.NormaliseDomain(dnsDomain)
+		dnsDomain, err = ens.NormaliseDomain(dnsDomain)
+		cli.ErrCheck(err, quiet, "Invalid domain")
 		outputIf(verbose, fmt.Sprintf("DNS domain is %s", dnsDomain))
 		ensDomain := strings.TrimSuffix(dnsDomain, ".")
 		outputIf(verbose, fmt.Sprintf("ENS domain is %s", ensDomain))
-		domainHash := ens.NameHash(ensDomain)
+		domainHash, err := ens.NameHash(ensDomain)
+		cli.ErrCheck(err, quiet, "Failed to obtain name hash")
 		outputIf(verbose, fmt.Sprintf("ENS domain hash is 0x%x", domainHash))
 
 		// Obtain the registry contract
@@ -132,9 +134,9 @@ In This will return an exit status of 0 if the transaction is successfully submi
 		cli.ErrCheck(err, quiet, "Failed to create transaction")
 		if offline {
 			if !quiet {
-				buf := new(bytes.Buffer)
-				signedTx.EncodeRLP(buf)
-				fmt.Printf("0x%s\n", hex.EncodeToString(buf.Bytes()))
+				data, err := signedTx.MarshalBinary()
+				cli.ErrCheck(err, quiet, "Failed to marshal transaction")
+				fmt.Printf("0x%s\n", hex.EncodeToString(data))
 			}
 			os.Exit(_exit_success)
 		}
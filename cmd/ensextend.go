@@ -24,7 +24,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/wealdtech/ethereal/cli"
-	ens "github.com/wealdtech/go-ens/v2"
+	ens "github.com/wealdtech/go-ens/v3"
 	string2eth "github.com/wealdtech/go-string2eth"
 )
 
@@ -66,7 +66,8 @@ This will return an exit status of 0 if the transactions are successfully submit
 		// Extend loop
 		var lastTx *types.Transaction
 		for _, domain := range domains {
-			domain = ens.NormaliseDomain(domain)
+			domain, err = ens.NormaliseDomain(domain)
+			cli.ErrCheck(err, quiet, "Invalid domain")
 
 			// Ensure the domain is owned
 			registry, err := ens.NewRegistry(client)
@@ -85,7 +86,7 @@ This will return an exit status of 0 if the transactions are successfully submit
 			expiryTS, err := registrar.Expiry(domain)
 			cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to obtain expiry for %s", domain))
 			expiry := time.Unix(expiryTS.Int64(), 0)
-			outputIf(verbose, fmt.Sprintf("%s expires at %s", expiry.Format("2006-01-02 15:04")))
+			outputIf(verbose, fmt.Sprintf("%s expires at %s", domain, expiry.Format("2006-01-02 15:04")))
 
 			costPerSecond, err := controller.RentCost(domain)
 			cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to obtain rental cost for %s", domain))
@@ -22,7 +22,8 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/wealdtech/ethereal/cli"
-	ens "github.com/wealdtech/go-ens/v2"
+	"github.com/wealdtech/ethereal/util/output"
+	ens "github.com/wealdtech/go-ens/v3"
 	string2eth "github.com/wealdtech/go-string2eth"
 )
 
@@ -41,16 +42,32 @@ In quiet mode this will return 0 if the domain is owned, otherwise 1.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		cli.Assert(ensDomain != "", quiet, "--domain is required")
 
-		ensDomain = ens.NormaliseDomain(ensDomain)
+		ensDomain, err = ens.NormaliseDomain(ensDomain)
+		cli.ErrCheck(err, quiet, "Invalid domain")
 
 		// Domain information
 		outputIf(verbose, fmt.Sprintf("Normalised domain is %s", ensDomain))
 		outputIf(verbose, fmt.Sprintf("Top-level domain is %s", ens.Tld(ensDomain)))
 		outputIf(verbose, fmt.Sprintf("Domain level is %v", ens.DomainLevel(ensDomain)))
-		outputIf(verbose, fmt.Sprintf("Name hash is 0x%x", ens.NameHash(ensDomain)))
+		nameHash, err := ens.NameHash(ensDomain)
+		cli.ErrCheck(err, quiet, "Failed to obtain name hash")
+		outputIf(verbose, fmt.Sprintf("Name hash is 0x%x", nameHash))
 		label, _ := ens.DomainPart(ensDomain, 1)
 		outputIf(verbose, fmt.Sprintf("Label is %s", label))
-		outputIf(verbose, fmt.Sprintf("Label hash is 0x%x", ens.LabelHash(label)))
+		labelHash, err := ens.LabelHash(label)
+		cli.ErrCheck(err, quiet, "Failed to obtain label hash")
+		outputIf(verbose, fmt.Sprintf("Label hash is 0x%x", labelHash))
+
+		if structuredOutput() {
+			result := ensInfoResult(ensDomain)
+			result.NameHash = fmt.Sprintf("%#x", nameHash)
+			result.LabelHash = fmt.Sprintf("%#x", labelHash)
+			outputResult(result)
+			if result.Controller == "" {
+				os.Exit(_exit_failure)
+			}
+			os.Exit(_exit_success)
+		}
 
 		if ens.DomainLevel(ensDomain) == 1 && ens.Tld(ensDomain) == "eth" {
 			// Work out if this is on the old or new .eth registrar and act accordingly
@@ -233,6 +250,61 @@ func ownedInfo(registrar *ens.AuctionRegistrar, name string) {
 	}
 }
 
+// ensInfoResult creates the structured result for an ENS domain.
+func ensInfoResult(name string) *output.ENSInfo {
+	result := &output.ENSInfo{
+		Domain: name,
+	}
+
+	if ens.DomainLevel(name) == 1 && ens.Tld(name) == "eth" {
+		registrar, err := ens.NewBaseRegistrar(client, ens.Tld(name))
+		cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to obtain ENS registrar contract for %s", ens.Tld(name)))
+		result.Registrar, err = registrar.RegisteredWith(name)
+		if err == nil && result.Registrar == "permanent" {
+			domain, _ := ens.DomainPart(name, 1)
+			registrant, err := registrar.Owner(domain)
+			if err == nil && registrant != ens.UnknownAddress {
+				result.Registrant = registrant.Hex()
+				expiry, err := registrar.Expiry(domain)
+				if err == nil {
+					result.Expiry = expiry.Uint64()
+				}
+			}
+		}
+	}
+
+	registry, err := ens.NewRegistry(client)
+	cli.ErrCheck(err, quiet, "Failed to obtain registry contract")
+	controllerAddress, err := registry.Owner(name)
+	cli.ErrCheck(err, quiet, "Failed to obtain controller")
+	if controllerAddress == ens.UnknownAddress {
+		return result
+	}
+	result.Controller = controllerAddress.Hex()
+
+	resolverAddress, err := registry.ResolverAddress(name)
+	if err != nil || resolverAddress == ens.UnknownAddress {
+		return result
+	}
+	result.Resolver = resolverAddress.Hex()
+
+	address, err := ens.Resolve(client, name)
+	if err == nil && address != ens.UnknownAddress {
+		result.Address = address.Hex()
+		result.ReverseName, _ = ens.ReverseResolve(client, address)
+	}
+
+	resolver, err := ens.NewResolverAt(client, name, resolverAddress)
+	if err == nil {
+		bytes, err := resolver.Contenthash()
+		if err == nil && len(bytes) > 0 {
+			result.ContentHash, _ = ens.ContenthashToString(bytes)
+		}
+	}
+
+	return result
+}
+
 // It is possible for an unregistered domain to have a resolver; report if this is the case
 func unregisteredResolverCheck(domain string) {
 	registry, err := ens.NewRegistry(client)
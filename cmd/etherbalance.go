@@ -22,7 +22,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/cobra"
 	"github.com/wealdtech/ethereal/cli"
-	ens "github.com/wealdtech/go-ens/v2"
+	"github.com/wealdtech/ethereal/util/output"
+	ens "github.com/wealdtech/go-ens/v3"
 	string2eth "github.com/wealdtech/go-string2eth"
 )
 
@@ -66,6 +67,21 @@ In quiet mode this will return 0 if the balance is greater than 0, otherwise 1.`
 		cli.Assert(err == nil || !strings.HasPrefix(err.Error(), "missing trie node"), quiet, "Connection does not have information on that block, please change the connection parameter to point to a full synced node")
 		cli.ErrCheck(err, quiet, "Failed to obtain balance")
 
+		if structuredOutput() {
+			result := &output.EtherBalance{
+				Address: address.Hex(),
+				Balance: balance.String(),
+			}
+			if blockNumber != nil {
+				result.Block = blockNumber.String()
+			}
+			outputResult(result)
+			if balance.Cmp(big.NewInt(0)) == 0 {
+				os.Exit(_exit_failure)
+			}
+			os.Exit(_exit_success)
+		}
+
 		if balance.Cmp(big.NewInt(0)) == 0 {
 			outputIf(!quiet, "0")
 			os.Exit(_exit_failure)
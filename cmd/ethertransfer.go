@@ -14,16 +14,17 @@
 package cmd
 
 import (
-	"bytes"
 	"encoding/hex"
 	"fmt"
+	"math/big"
 	"os"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/wealdtech/ethereal/cli"
-	ens "github.com/wealdtech/go-ens/v2"
+	ens "github.com/wealdtech/go-ens/v3"
 	string2eth "github.com/wealdtech/go-string2eth"
 )
 
@@ -40,13 +41,27 @@ var etherTransferCmd = &cobra.Command{
 
     ethereal ether transfer --from=0x5FfC014343cd971B7eb70732021E26C35B744cc4 --to=0x52f1A3027d3aA514F17E454C93ae1F79b3B12d5d --amount=1.5ether --passphrase=secret
 
-This will return an exit status of 0 if the transaction is successfully submitted (and mined if --wait is supplied), 1 if the transaction is not successfully submitted, and 2 if the transaction is successfully submitted but not mined within the supplied time limit.`,
+Multiple transfers can be sent at once by supplying a CSV file with one transfer per line in place of --to and --amount, for example:
+
+    ethereal ether transfer --from=0x5FfC014343cd971B7eb70732021E26C35B744cc4 --batch=payroll.csv --passphrase=secret
+
+Each line of the file contains the recipient, the amount and optionally data for the transaction; data starting with 0x is sent as hex, anything else as a text memo.  All transfers are validated before any are sent, and the results are written to --batchresults (default the batch file with a .results suffix).  Running the same command again resumes the batch, skipping transfers that have already been submitted.
+
+This will return an exit status of 0 if the transaction is successfully submitted (and mined if --wait is supplied), 1 if the transaction is not successfully submitted, and 2 if the transaction is successfully submitted but not mined within the supplied time limit.  For a batch the status covers all of its transfers.`,
 	Aliases: []string{"send"},
 	Run: func(cmd *cobra.Command, args []string) {
 		cli.Assert(etherTransferFromAddress != "", quiet, "--from is required")
 		fromAddress, err := ens.Resolve(client, etherTransferFromAddress)
 		cli.ErrCheck(err, quiet, "Failed to obtain from address for transfer")
 
+		if batchFile != "" {
+			cli.Assert(etherTransferToAddress == "" && etherTransferAmount == "" && etherTransferData == "", quiet, "--to, --amount and --data cannot be supplied with --batch")
+			runBatchTransfers(fromAddress, etherBatchSpec(), log.Fields{
+				"group":   "ether",
+				"command": "transfer",
+			})
+		}
+
 		cli.Assert(etherTransferToAddress != "", quiet, "--to is required")
 		toAddress, err := ens.Resolve(client, etherTransferToAddress)
 		cli.ErrCheck(err, quiet, "Failed to obtain to address for transfer")
@@ -77,9 +92,9 @@ This will return an exit status of 0 if the transaction is successfully submitte
 
 		if offline {
 			if !quiet {
-				buf := new(bytes.Buffer)
-				signedTx.EncodeRLP(buf)
-				fmt.Printf("0x%s\n", hex.EncodeToString(buf.Bytes()))
+				data, err := signedTx.MarshalBinary()
+				cli.ErrCheck(err, quiet, "Failed to marshal transaction")
+				fmt.Printf("0x%s\n", hex.EncodeToString(data))
 			}
 			os.Exit(_exit_success)
 		}
@@ -96,11 +111,32 @@ This will return an exit status of 0 if the transaction is successfully submitte
 	},
 }
 
+// etherBatchSpec describes batch transfers of Ether.
+func etherBatchSpec() *batchSpec {
+	return &batchSpec{
+		ether:        true,
+		parseAmount:  string2eth.StringToWei,
+		formatAmount: func(amount *big.Int) string { return string2eth.WeiToString(amount, true) },
+		txParams: func(to common.Address, amount *big.Int, data string) (*common.Address, *big.Int, []byte, error) {
+			if !strings.HasPrefix(data, "0x") {
+				// Memo
+				return &to, amount, []byte(data), nil
+			}
+			txData, err := hex.DecodeString(strings.TrimPrefix(data, "0x"))
+			if err != nil {
+				return nil, nil, nil, fmt.Errorf("invalid data %s", data)
+			}
+			return &to, amount, txData, nil
+		},
+	}
+}
+
 func init() {
 	etherCmd.AddCommand(etherTransferCmd)
 	etherTransferCmd.Flags().StringVar(&etherTransferAmount, "amount", "", "Amount of Ether to transfer")
 	etherTransferCmd.Flags().StringVar(&etherTransferFromAddress, "from", "", "Address from which to transfer Ether")
 	etherTransferCmd.Flags().StringVar(&etherTransferToAddress, "to", "", "Address to which to transfer Ether")
 	etherTransferCmd.Flags().StringVar(&etherTransferData, "data", "", "data to send with transaction (as a hex string)")
+	addBatchFlags(etherTransferCmd)
 	addTransactionFlags(etherTransferCmd, "the address from which to transfer Ether")
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/wealdtech/ethereal/cli"
 	"github.com/wealdtech/ethereal/util"
+	"github.com/wealdtech/ethereal/util/output"
 	string2eth "github.com/wealdtech/go-string2eth"
 )
 
@@ -132,6 +133,14 @@ In quiet mode this will return 0 if it can calculate a gas price, otherwise 1.`,
 			os.Exit(_exit_success)
 		}
 
+		if structuredOutput() {
+			outputResult(&output.GasPrice{
+				Blocks:   gasPriceBlocks,
+				GasPrice: finalGasPrice.String(),
+			})
+			os.Exit(_exit_success)
+		}
+
 		if gasPriceWei {
 			fmt.Printf("%s\n", finalGasPrice.String())
 		} else {
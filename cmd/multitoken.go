@@ -0,0 +1,101 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+	"github.com/wealdtech/ethereal/util"
+	"github.com/wealdtech/ethereal/util/contracts"
+)
+
+var multiTokenStr string
+var multiTokenIDStr string
+
+// multiTokenCmd represents the multitoken command
+var multiTokenCmd = &cobra.Command{
+	Use:     "multitoken",
+	Aliases: []string{"erc1155"},
+	Short:   "Manage multi-tokens",
+	Long:    `Obtain information about, balances of and transfer ERC-1155 multi-tokens.`,
+}
+
+// Obtain the multi-token contract given a string
+func multiTokenContract(input string) (contract *contracts.ERC1155, err error) {
+	address, err := util.TokenAddress(client, input)
+	if err == nil {
+		contract, err = contracts.NewERC1155(address, client)
+	}
+	return
+}
+
+// Obtain the ID of the token supplied by the user, as decimal or hex
+func multiTokenID() (*big.Int, error) {
+	if multiTokenIDStr == "" {
+		return nil, fmt.Errorf("--tokenid is required")
+	}
+	return parseTokenID(multiTokenIDStr)
+}
+
+// Obtain a list of token IDs from a comma-separated string
+func multiTokenIDs(input string) ([]*big.Int, error) {
+	ids := make([]*big.Int, 0)
+	for _, idStr := range strings.Split(input, ",") {
+		id, err := parseTokenID(strings.TrimSpace(idStr))
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// Obtain a list of token amounts from a comma-separated string
+func multiTokenAmounts(input string) ([]*big.Int, error) {
+	amounts := make([]*big.Int, 0)
+	for _, amountStr := range strings.Split(input, ",") {
+		amountStr = strings.TrimSpace(amountStr)
+		amount, success := new(big.Int).SetString(amountStr, 10)
+		if !success || amount.Sign() <= 0 {
+			return nil, fmt.Errorf("invalid amount %s", amountStr)
+		}
+		amounts = append(amounts, amount)
+	}
+	return amounts, nil
+}
+
+// multiTokenBalances obtains the balances of a holder for a number of tokens
+func multiTokenBalances(token *contracts.ERC1155, holder common.Address, ids []*big.Int) ([]*big.Int, error) {
+	holders := make([]common.Address, len(ids))
+	for i := range holders {
+		holders[i] = holder
+	}
+	return token.BalanceOfBatch(nil, holders, ids)
+}
+
+func init() {
+	RootCmd.AddCommand(multiTokenCmd)
+}
+
+func multiTokenFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&multiTokenStr, "token", "", "Name (resolved as <name>.thetoken.eth) or address of the multi-token contract")
+}
+
+func multiTokenIDFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&multiTokenIDStr, "tokenid", "", "ID of the token, in decimal or hex with a 0x prefix")
+}
@@ -0,0 +1,66 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wealdtech/ethereal/cli"
+	ens "github.com/wealdtech/go-ens/v3"
+)
+
+var multiTokenBalanceHolderAddress string
+
+// multiTokenBalanceCmd represents the multitoken balance command
+var multiTokenBalanceCmd = &cobra.Command{
+	Use:   "balance",
+	Short: "Obtain the balance of a multi-token for an address",
+	Long: `Obtain the balance of a multi-token for an address.  For example:
+
+    ethereal multitoken balance --token=0x76BE3b62873462d2142405439777e971754E8E77 --tokenid=10 --holder=0x5FfC014343cd971B7eb70732021E26C35B744cc4
+
+In quiet mode this will return 0 if the balance is greater than 0, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli.Assert(multiTokenBalanceHolderAddress != "", quiet, "--holder is required")
+		address, err := ens.Resolve(client, multiTokenBalanceHolderAddress)
+		cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to resolve holder address %s", multiTokenBalanceHolderAddress))
+
+		cli.Assert(multiTokenStr != "", quiet, "--token is required")
+		token, err := multiTokenContract(multiTokenStr)
+		cli.ErrCheck(err, quiet, "Failed to obtain multi-token contract")
+		tokenID, err := multiTokenID()
+		cli.ErrCheck(err, quiet, "Invalid token ID")
+
+		balance, err := token.BalanceOf(nil, address, tokenID)
+		cli.ErrCheck(err, quiet, "Failed to obtain balance")
+
+		if quiet {
+			if balance.Sign() == 0 {
+				os.Exit(_exit_failure)
+			}
+			os.Exit(_exit_success)
+		}
+
+		fmt.Printf("%s\n", balance.String())
+	},
+}
+
+func init() {
+	multiTokenCmd.AddCommand(multiTokenBalanceCmd)
+	multiTokenFlags(multiTokenBalanceCmd)
+	multiTokenIDFlag(multiTokenBalanceCmd)
+	multiTokenBalanceCmd.Flags().StringVar(&multiTokenBalanceHolderAddress, "holder", "", "Address of the holder of the token")
+}
@@ -0,0 +1,101 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+	"github.com/wealdtech/ethereal/cli"
+	ens "github.com/wealdtech/go-ens/v3"
+)
+
+var multiTokenBalanceOfBatchHolders string
+var multiTokenBalanceOfBatchTokenIDs string
+
+// multiTokenBalanceOfBatchCmd represents the multitoken balanceofbatch command
+var multiTokenBalanceOfBatchCmd = &cobra.Command{
+	Use:   "balanceofbatch",
+	Short: "Obtain the balances of multiple multi-tokens and addresses",
+	Long: `Obtain the balances of multiple multi-tokens and addresses in a single call.  For example:
+
+    ethereal multitoken balanceofbatch --token=0x76BE3b62873462d2142405439777e971754E8E77 --holders=0x5FfC014343cd971B7eb70732021E26C35B744cc4,0x52f1A3027d3aA514F17E454C93ae1F79b3B12d5d --tokenids=10,11
+
+--holders and --tokenids are comma-separated lists that are paired in order, so must be the same length.  If a single holder is supplied its balances of all of the tokens are returned, and if a single token ID is supplied the balances of all of the holders are returned.
+
+The output is one line for each pair, with the holder, token ID and balance separated by tabs.
+
+In quiet mode this will return 0 if any balance is greater than 0, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli.Assert(multiTokenBalanceOfBatchHolders != "", quiet, "--holders is required")
+		holderStrs := strings.Split(multiTokenBalanceOfBatchHolders, ",")
+		holders := make([]common.Address, len(holderStrs))
+		for i := range holderStrs {
+			holderStrs[i] = strings.TrimSpace(holderStrs[i])
+			var err error
+			holders[i], err = ens.Resolve(client, holderStrs[i])
+			cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to resolve holder address %s", holderStrs[i]))
+		}
+
+		cli.Assert(multiTokenBalanceOfBatchTokenIDs != "", quiet, "--tokenids is required")
+		tokenIDs, err := multiTokenIDs(multiTokenBalanceOfBatchTokenIDs)
+		cli.ErrCheck(err, quiet, "Invalid token IDs")
+
+		switch {
+		case len(holders) == len(tokenIDs):
+		case len(holders) == 1:
+			for len(holders) < len(tokenIDs) {
+				holders = append(holders, holders[0])
+				holderStrs = append(holderStrs, holderStrs[0])
+			}
+		case len(tokenIDs) == 1:
+			for len(tokenIDs) < len(holders) {
+				tokenIDs = append(tokenIDs, tokenIDs[0])
+			}
+		default:
+			cli.Err(quiet, "--holders and --tokenids must contain the same number of entries")
+		}
+
+		cli.Assert(multiTokenStr != "", quiet, "--token is required")
+		token, err := multiTokenContract(multiTokenStr)
+		cli.ErrCheck(err, quiet, "Failed to obtain multi-token contract")
+
+		balances, err := token.BalanceOfBatch(nil, holders, tokenIDs)
+		cli.ErrCheck(err, quiet, "Failed to obtain balances")
+		cli.Assert(len(balances) == len(holders), quiet, "Contract returned an incorrect number of balances")
+
+		found := false
+		for i := range balances {
+			if balances[i].Sign() > 0 {
+				found = true
+			}
+			outputIf(!quiet, fmt.Sprintf("%s\t%s\t%s", holderStrs[i], tokenIDs[i].String(), balances[i].String()))
+		}
+
+		if quiet && !found {
+			os.Exit(_exit_failure)
+		}
+		os.Exit(_exit_success)
+	},
+}
+
+func init() {
+	multiTokenCmd.AddCommand(multiTokenBalanceOfBatchCmd)
+	multiTokenFlags(multiTokenBalanceOfBatchCmd)
+	multiTokenBalanceOfBatchCmd.Flags().StringVar(&multiTokenBalanceOfBatchHolders, "holders", "", "Comma-separated list of addresses of holders of the tokens")
+	multiTokenBalanceOfBatchCmd.Flags().StringVar(&multiTokenBalanceOfBatchTokenIDs, "tokenids", "", "Comma-separated list of IDs of the tokens, in decimal or hex with a 0x prefix")
+}
@@ -0,0 +1,114 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/wealdtech/ethereal/cli"
+	ens "github.com/wealdtech/go-ens/v3"
+)
+
+var multiTokenSafeBatchTransferTokenIDs string
+var multiTokenSafeBatchTransferAmounts string
+
+// multiTokenSafeBatchTransferCmd represents the multitoken safebatchtransfer command
+var multiTokenSafeBatchTransferCmd = &cobra.Command{
+	Use:   "safebatchtransfer",
+	Short: "Transfer multiple multi-tokens to a given address",
+	Long: `Transfer amounts of multiple multi-tokens from one address to another in a single transaction with safeBatchTransferFrom.  For example:
+
+    ethereal multitoken safebatchtransfer --token=0x76BE3b62873462d2142405439777e971754E8E77 --tokenids=10,11 --amounts=5,1 --from=0x5FfC014343cd971B7eb70732021E26C35B744cc4 --to=0x52f1A3027d3aA514F17E454C93ae1F79b3B12d5d --passphrase=secret
+
+--tokenids and --amounts are comma-separated lists that are paired in order, so must be the same length.  The tokens are transferred from --holder, which defaults to --from; if they differ then --from must be approved as an operator for --holder.  The transfer fails if the recipient is a contract that cannot accept the tokens.  Data supplied with --data is passed to the recipient.
+
+This will return an exit status of 0 if the transaction is successfully submitted (and mined if --wait is supplied), 1 if the transaction is not successfully submitted, and 2 if the transaction is successfully submitted but not mined within the supplied time limit.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		fromAddress, holderAddress, toAddress, data := multiTokenTransferParams()
+
+		cli.Assert(multiTokenStr != "", quiet, "--token is required")
+		token, err := multiTokenContract(multiTokenStr)
+		cli.ErrCheck(err, quiet, "Failed to obtain multi-token contract")
+
+		cli.Assert(multiTokenSafeBatchTransferTokenIDs != "", quiet, "--tokenids is required")
+		tokenIDs, err := multiTokenIDs(multiTokenSafeBatchTransferTokenIDs)
+		cli.ErrCheck(err, quiet, "Invalid token IDs")
+		cli.Assert(multiTokenSafeBatchTransferAmounts != "", quiet, "--amounts is required")
+		amounts, err := multiTokenAmounts(multiTokenSafeBatchTransferAmounts)
+		cli.ErrCheck(err, quiet, "Invalid amounts")
+		cli.Assert(len(tokenIDs) == len(amounts), quiet, "--tokenids and --amounts must contain the same number of entries")
+
+		if !offline {
+			multiTokenCheckOperator(token, holderAddress, fromAddress)
+			balances, err := multiTokenBalances(token, holderAddress, tokenIDs)
+			cli.ErrCheck(err, quiet, "Failed to obtain balances")
+			cli.Assert(len(balances) == len(tokenIDs), quiet, "Contract returned an incorrect number of balances")
+			// The same token can be supplied more than once, so check totals.
+			totals := make(map[string]*big.Int)
+			for i := range tokenIDs {
+				id := tokenIDs[i].String()
+				if _, exists := totals[id]; !exists {
+					totals[id] = new(big.Int)
+				}
+				totals[id].Add(totals[id], amounts[i])
+				cli.Assert(balances[i].Cmp(totals[id]) >= 0, quiet, fmt.Sprintf("Balance of token %s for %s is insufficient for transfer", id, ens.Format(client, holderAddress)))
+			}
+		}
+
+		opts, err := generateTxOpts(fromAddress)
+		cli.ErrCheck(err, quiet, "Failed to generate transaction options")
+
+		signedTx, err := token.SafeBatchTransferFrom(opts, holderAddress, toAddress, tokenIDs, amounts, data)
+		cli.ErrCheck(err, quiet, "Failed to create transaction")
+
+		if offline {
+			if !quiet {
+				data, err := signedTx.MarshalBinary()
+				cli.ErrCheck(err, quiet, "Failed to marshal transaction")
+				fmt.Printf("0x%s\n", hex.EncodeToString(data))
+			}
+			os.Exit(_exit_success)
+		}
+
+		tokenIDStrs := make([]string, len(tokenIDs))
+		amountStrs := make([]string, len(amounts))
+		for i := range tokenIDs {
+			tokenIDStrs[i] = tokenIDs[i].String()
+			amountStrs[i] = amounts[i].String()
+		}
+		handleSubmittedTransaction(signedTx, log.Fields{
+			"group":          "multitoken",
+			"command":        "safebatchtransfer",
+			"token":          multiTokenStr,
+			"tokenids":       strings.Join(tokenIDStrs, ","),
+			"amounts":        strings.Join(amountStrs, ","),
+			"tokenholder":    holderAddress.Hex(),
+			"tokensender":    fromAddress.Hex(),
+			"tokenrecipient": toAddress.Hex(),
+		}, true)
+	},
+}
+
+func init() {
+	multiTokenCmd.AddCommand(multiTokenSafeBatchTransferCmd)
+	multiTokenTransferFlags(multiTokenSafeBatchTransferCmd)
+	multiTokenSafeBatchTransferCmd.Flags().StringVar(&multiTokenSafeBatchTransferTokenIDs, "tokenids", "", "Comma-separated list of IDs of the tokens, in decimal or hex with a 0x prefix")
+	multiTokenSafeBatchTransferCmd.Flags().StringVar(&multiTokenSafeBatchTransferAmounts, "amounts", "", "Comma-separated list of numbers of tokens to transfer")
+}
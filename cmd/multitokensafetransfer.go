@@ -0,0 +1,148 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/wealdtech/ethereal/cli"
+	"github.com/wealdtech/ethereal/util/contracts"
+	ens "github.com/wealdtech/go-ens/v3"
+)
+
+var multiTokenTransferFromAddress string
+var multiTokenTransferHolderAddress string
+var multiTokenTransferToAddress string
+var multiTokenTransferAmount string
+var multiTokenTransferData string
+
+// multiTokenSafeTransferCmd represents the multitoken safetransfer command
+var multiTokenSafeTransferCmd = &cobra.Command{
+	Use:   "safetransfer",
+	Short: "Transfer a multi-token to a given address",
+	Long: `Transfer an amount of a multi-token from one address to another with safeTransferFrom.  For example:
+
+    ethereal multitoken safetransfer --token=0x76BE3b62873462d2142405439777e971754E8E77 --tokenid=10 --amount=5 --from=0x5FfC014343cd971B7eb70732021E26C35B744cc4 --to=0x52f1A3027d3aA514F17E454C93ae1F79b3B12d5d --passphrase=secret
+
+The tokens are transferred from --holder, which defaults to --from; if they differ then --from must be approved as an operator for --holder.  The transfer fails if the recipient is a contract that cannot accept the token.  Data supplied with --data is passed to the recipient.
+
+This will return an exit status of 0 if the transaction is successfully submitted (and mined if --wait is supplied), 1 if the transaction is not successfully submitted, and 2 if the transaction is successfully submitted but not mined within the supplied time limit.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		fromAddress, holderAddress, toAddress, data := multiTokenTransferParams()
+
+		cli.Assert(multiTokenStr != "", quiet, "--token is required")
+		token, err := multiTokenContract(multiTokenStr)
+		cli.ErrCheck(err, quiet, "Failed to obtain multi-token contract")
+		tokenID, err := multiTokenID()
+		cli.ErrCheck(err, quiet, "Invalid token ID")
+
+		cli.Assert(multiTokenTransferAmount != "", quiet, "--amount is required")
+		amounts, err := multiTokenAmounts(multiTokenTransferAmount)
+		cli.ErrCheck(err, quiet, "Invalid amount")
+		cli.Assert(len(amounts) == 1, quiet, "--amount must be a single value; use 'multitoken safebatchtransfer' to transfer multiple tokens")
+		amount := amounts[0]
+
+		if !offline {
+			multiTokenCheckOperator(token, holderAddress, fromAddress)
+			balance, err := token.BalanceOf(nil, holderAddress, tokenID)
+			cli.ErrCheck(err, quiet, "Failed to obtain balance")
+			cli.Assert(balance.Cmp(amount) >= 0, quiet, fmt.Sprintf("Balance of %s is insufficient for transfer", ens.Format(client, holderAddress)))
+		}
+
+		opts, err := generateTxOpts(fromAddress)
+		cli.ErrCheck(err, quiet, "Failed to generate transaction options")
+
+		signedTx, err := token.SafeTransferFrom(opts, holderAddress, toAddress, tokenID, amount, data)
+		cli.ErrCheck(err, quiet, "Failed to create transaction")
+
+		if offline {
+			if !quiet {
+				data, err := signedTx.MarshalBinary()
+				cli.ErrCheck(err, quiet, "Failed to marshal transaction")
+				fmt.Printf("0x%s\n", hex.EncodeToString(data))
+			}
+			os.Exit(_exit_success)
+		}
+
+		handleSubmittedTransaction(signedTx, log.Fields{
+			"group":          "multitoken",
+			"command":        "safetransfer",
+			"token":          multiTokenStr,
+			"tokenid":        tokenID.String(),
+			"amount":         amount.String(),
+			"tokenholder":    holderAddress.Hex(),
+			"tokensender":    fromAddress.Hex(),
+			"tokenrecipient": toAddress.Hex(),
+		}, true)
+	},
+}
+
+// multiTokenTransferParams obtains the sender, holder, recipient and data for
+// a multi-token transfer.
+func multiTokenTransferParams() (common.Address, common.Address, common.Address, []byte) {
+	cli.Assert(multiTokenTransferFromAddress != "", quiet, "--from is required")
+	fromAddress, err := ens.Resolve(client, multiTokenTransferFromAddress)
+	cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to resolve from address %s", multiTokenTransferFromAddress))
+
+	holderAddress := fromAddress
+	if multiTokenTransferHolderAddress != "" {
+		holderAddress, err = ens.Resolve(client, multiTokenTransferHolderAddress)
+		cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to resolve holder address %s", multiTokenTransferHolderAddress))
+	}
+
+	cli.Assert(multiTokenTransferToAddress != "", quiet, "--to is required")
+	toAddress, err := ens.Resolve(client, multiTokenTransferToAddress)
+	cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to resolve to address %s", multiTokenTransferToAddress))
+
+	data := make([]byte, 0)
+	if multiTokenTransferData != "" {
+		data, err = hex.DecodeString(strings.TrimPrefix(multiTokenTransferData, "0x"))
+		cli.ErrCheck(err, quiet, "Failed to parse data")
+	}
+
+	return fromAddress, holderAddress, toAddress, data
+}
+
+// multiTokenCheckOperator ensures that the sender can transfer the tokens of
+// the holder.
+func multiTokenCheckOperator(token *contracts.ERC1155, holderAddress common.Address, fromAddress common.Address) {
+	if holderAddress == fromAddress {
+		return
+	}
+	approvedForAll, err := token.IsApprovedForAll(nil, holderAddress, fromAddress)
+	cli.ErrCheck(err, quiet, "Failed to obtain operator approval")
+	cli.Assert(approvedForAll, quiet, fmt.Sprintf("%s is not approved to transfer the tokens of %s", ens.Format(client, fromAddress), ens.Format(client, holderAddress)))
+}
+
+func multiTokenTransferFlags(cmd *cobra.Command) {
+	multiTokenFlags(cmd)
+	cmd.Flags().StringVar(&multiTokenTransferFromAddress, "from", "", "Address from which to send the transaction")
+	cmd.Flags().StringVar(&multiTokenTransferHolderAddress, "holder", "", "Address that holds the tokens (default --from)")
+	cmd.Flags().StringVar(&multiTokenTransferToAddress, "to", "", "Address to which to transfer the tokens")
+	cmd.Flags().StringVar(&multiTokenTransferData, "data", "", "data to pass to the recipient (as a hex string)")
+	addTransactionFlags(cmd, "the address from which to send the transaction")
+}
+
+func init() {
+	multiTokenCmd.AddCommand(multiTokenSafeTransferCmd)
+	multiTokenTransferFlags(multiTokenSafeTransferCmd)
+	multiTokenIDFlag(multiTokenSafeTransferCmd)
+	multiTokenSafeTransferCmd.Flags().StringVar(&multiTokenTransferAmount, "amount", "", "Number of tokens to transfer")
+}
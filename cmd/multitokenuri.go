@@ -0,0 +1,73 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wealdtech/ethereal/cli"
+	"github.com/wealdtech/ethereal/util"
+)
+
+var multiTokenURIRaw bool
+
+// multiTokenURICmd represents the multitoken uri command
+var multiTokenURICmd = &cobra.Command{
+	Use:   "uri",
+	Short: "Obtain the metadata URI of a multi-token",
+	Long: `Obtain the metadata URI of a multi-token.  For example:
+
+    ethereal multitoken uri --token=0x76BE3b62873462d2142405439777e971754E8E77 --tokenid=10
+
+Any instance of {id} in the URI is replaced with the token ID in hex, as per ERC-1155.  Metadata held on-chain as a data: URI is decoded, and JSON metadata is formatted for display.  Use --raw to show the URI as returned by the contract.
+
+In quiet mode this will return 0 if the token has a URI, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli.Assert(multiTokenStr != "", quiet, "--token is required")
+		token, err := multiTokenContract(multiTokenStr)
+		cli.ErrCheck(err, quiet, "Failed to obtain multi-token contract")
+		tokenID, err := multiTokenID()
+		cli.ErrCheck(err, quiet, "Invalid token ID")
+
+		uri, err := token.Uri(nil, tokenID)
+		cli.ErrCheck(err, quiet, "Failed to obtain token URI")
+
+		if quiet {
+			if uri == "" {
+				os.Exit(_exit_failure)
+			}
+			os.Exit(_exit_success)
+		}
+
+		if multiTokenURIRaw {
+			fmt.Printf("%s\n", uri)
+			os.Exit(_exit_success)
+		}
+		uri = util.ERC1155URI(uri, tokenID)
+		if !util.IsDataURI(uri) {
+			fmt.Printf("%s\n", uri)
+			os.Exit(_exit_success)
+		}
+		fmt.Printf("%s\n", decodeMetadataURI(uri))
+	},
+}
+
+func init() {
+	multiTokenCmd.AddCommand(multiTokenURICmd)
+	multiTokenFlags(multiTokenURICmd)
+	multiTokenIDFlag(multiTokenURICmd)
+	multiTokenURICmd.Flags().BoolVar(&multiTokenURIRaw, "raw", false, "Display the URI without substituting the token ID or decoding it")
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/wealdtech/ethereal/cli"
+	"github.com/wealdtech/ethereal/util/output"
 )
 
 var networkBlocktimeBlocks int64
@@ -119,6 +120,14 @@ In quiet mode this will return 0 if the blocks exist, otherwise 1.`,
 		}
 
 		gap := lastBlockTime.Sub(oldBlockTime) / time.Duration(new(big.Int).Sub(lastBlockNumber, oldBlockNumber).Int64())
+		if structuredOutput() {
+			outputResult(&output.NetworkBlockTime{
+				FromBlock: oldBlockNumber.Uint64(),
+				ToBlock:   lastBlockNumber.Uint64(),
+				BlockTime: ((gap / 10000000) * 10000000).Seconds(),
+			})
+			os.Exit(_exit_success)
+		}
 		fmt.Printf("%v\n", (gap/10000000)*10000000)
 	},
 }
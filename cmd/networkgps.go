@@ -15,12 +15,14 @@ package cmd
 
 import (
 	"fmt"
+	"math"
 	"math/big"
 	"os"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/wealdtech/ethereal/cli"
+	"github.com/wealdtech/ethereal/util/output"
 )
 
 var networkGPSBlocks int64
@@ -76,6 +78,15 @@ In quiet mode this will return 0 if the network is processing transactions, othe
 		}
 
 		gasPerSecond := float64(gas) / duration
+		if structuredOutput() {
+			outputResult(&output.NetworkGPS{
+				Blocks:       networkGPSBlocks,
+				Gas:          gas,
+				Duration:     duration,
+				GasPerSecond: uint64(math.Round(gasPerSecond)),
+			})
+			os.Exit(_exit_success)
+		}
 		fmt.Printf("%.0f\n", gasPerSecond)
 	},
 }
@@ -15,12 +15,14 @@ package cmd
 
 import (
 	"fmt"
+	"math"
 	"math/big"
 	"os"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/wealdtech/ethereal/cli"
+	"github.com/wealdtech/ethereal/util/output"
 )
 
 var networkTPSBlocks int64
@@ -76,6 +78,15 @@ In quiet mode this will return 0 if the network is processing transactions, othe
 		}
 
 		transactionsPerSecond := float64(transactions) / duration
+		if structuredOutput() {
+			outputResult(&output.NetworkTPS{
+				Blocks:                networkTPSBlocks,
+				Transactions:          transactions,
+				Duration:              duration,
+				TransactionsPerSecond: math.Round(transactionsPerSecond*100) / 100,
+			})
+			os.Exit(_exit_success)
+		}
 		fmt.Printf("%.2f\n", transactionsPerSecond)
 	},
 }
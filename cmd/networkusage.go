@@ -15,11 +15,13 @@ package cmd
 
 import (
 	"fmt"
+	"math"
 	"math/big"
 	"os"
 
 	"github.com/spf13/cobra"
 	"github.com/wealdtech/ethereal/cli"
+	"github.com/wealdtech/ethereal/util/output"
 )
 
 var networkUsageBlocks int64
@@ -66,6 +68,16 @@ In quiet mode this will return 0 if the network is processing transactions, othe
 		}
 
 		gasPct := big.NewFloat(0).Quo(big.NewFloat(0).Mul(big.NewFloat(100), big.NewFloat(0).SetInt(big.NewInt(int64(gas)))), big.NewFloat(0).SetInt(big.NewInt(int64(gasLimit))))
+		if structuredOutput() {
+			usage, _ := gasPct.Float64()
+			outputResult(&output.NetworkUsage{
+				Blocks:   networkUsageBlocks,
+				GasUsed:  gas,
+				GasLimit: gasLimit,
+				Usage:    math.Round(usage*100) / 100,
+			})
+			os.Exit(_exit_success)
+		}
 		fmt.Printf("%s%%\n", gasPct.Text('f', 2))
 	},
 }
@@ -0,0 +1,84 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+	"github.com/wealdtech/ethereal/util"
+	"github.com/wealdtech/ethereal/util/contracts"
+)
+
+var nftStr string
+var nftTokenIDStr string
+
+// nftCmd represents the nft command
+var nftCmd = &cobra.Command{
+	Use:   "nft",
+	Short: "Manage NFTs",
+	Long:  `Obtain information about, approve and transfer ERC-721 non-fungible tokens.`,
+}
+
+// Obtain the NFT contract address given a string
+func nftContractAddress(input string) (common.Address, error) {
+	return util.TokenAddress(client, input)
+}
+
+// Obtain the NFT contract given a string
+func nftContract(input string) (contract *contracts.ERC721, err error) {
+	address, err := nftContractAddress(input)
+	if err == nil {
+		contract, err = contracts.NewERC721(address, client)
+	}
+	return
+}
+
+// Obtain the ID of the token supplied by the user, as decimal or hex
+func nftTokenID() (*big.Int, error) {
+	if nftTokenIDStr == "" {
+		return nil, fmt.Errorf("--tokenid is required")
+	}
+	return parseTokenID(nftTokenIDStr)
+}
+
+// parseTokenID parses a token ID, as decimal or hex with a 0x prefix
+func parseTokenID(input string) (*big.Int, error) {
+	var id *big.Int
+	var success bool
+	if strings.HasPrefix(input, "0x") {
+		id, success = new(big.Int).SetString(input[2:], 16)
+	} else {
+		id, success = new(big.Int).SetString(input, 10)
+	}
+	if !success || id.Sign() < 0 {
+		return nil, fmt.Errorf("invalid token ID %s", input)
+	}
+	return id, nil
+}
+
+func init() {
+	RootCmd.AddCommand(nftCmd)
+}
+
+func nftFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&nftStr, "token", "", "Name (resolved as <name>.thetoken.eth) or address of the NFT contract")
+}
+
+func nftTokenIDFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&nftTokenIDStr, "tokenid", "", "ID of the token, in decimal or hex with a 0x prefix")
+}
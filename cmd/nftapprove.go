@@ -0,0 +1,107 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/wealdtech/ethereal/cli"
+	ens "github.com/wealdtech/go-ens/v3"
+)
+
+var nftApproveHolderAddress string
+var nftApproveSpenderAddress string
+var nftApproveClear bool
+
+// nftApproveCmd represents the nft approve command
+var nftApproveCmd = &cobra.Command{
+	Use:   "approve",
+	Short: "Approve an address to transfer an NFT",
+	Long: `Approve an address to transfer a single NFT on behalf of its owner.  For example:
+
+    ethereal nft approve --token=0x06012c8cf97BEaD5deAe237070F9587f8E7A266d --tokenid=1 --holder=0x5FfC014343cd971B7eb70732021E26C35B744cc4 --spender=0x52f1A3027d3aA514F17E454C93ae1F79b3B12d5d --passphrase=secret
+
+--holder can be either the owner of the token or an operator approved for all of the owner's tokens.  Use --clear in place of --spender to remove the approval.
+
+This will return an exit status of 0 if the transaction is successfully submitted (and mined if --wait is supplied), 1 if the transaction is not successfully submitted, and 2 if the transaction is successfully submitted but not mined within the supplied time limit.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli.Assert(nftApproveHolderAddress != "", quiet, "--holder is required")
+		holderAddress, err := ens.Resolve(client, nftApproveHolderAddress)
+		cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to resolve holder address %s", nftApproveHolderAddress))
+
+		var spenderAddress common.Address
+		if nftApproveClear {
+			cli.Assert(nftApproveSpenderAddress == "", quiet, "--spender cannot be supplied with --clear")
+		} else {
+			cli.Assert(nftApproveSpenderAddress != "", quiet, "--spender or --clear is required")
+			spenderAddress, err = ens.Resolve(client, nftApproveSpenderAddress)
+			cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to resolve spender address %s", nftApproveSpenderAddress))
+		}
+
+		cli.Assert(nftStr != "", quiet, "--token is required")
+		token, err := nftContract(nftStr)
+		cli.ErrCheck(err, quiet, "Failed to obtain NFT contract")
+		tokenID, err := nftTokenID()
+		cli.ErrCheck(err, quiet, "Invalid token ID")
+
+		if !offline {
+			owner, err := token.OwnerOf(nil, tokenID)
+			cli.ErrCheck(err, quiet, "Failed to obtain owner; does the token exist?")
+			if owner != holderAddress {
+				approvedForAll, err := token.IsApprovedForAll(nil, owner, holderAddress)
+				cli.ErrCheck(err, quiet, "Failed to obtain operator approval")
+				cli.Assert(approvedForAll, quiet, fmt.Sprintf("%s is neither the owner of the token nor an approved operator", ens.Format(client, holderAddress)))
+			}
+		}
+
+		opts, err := generateTxOpts(holderAddress)
+		cli.ErrCheck(err, quiet, "Failed to generate transaction options")
+
+		signedTx, err := token.Approve(opts, spenderAddress, tokenID)
+		cli.ErrCheck(err, quiet, "Failed to create transaction")
+
+		if offline {
+			if !quiet {
+				data, err := signedTx.MarshalBinary()
+				cli.ErrCheck(err, quiet, "Failed to marshal transaction")
+				fmt.Printf("0x%s\n", hex.EncodeToString(data))
+			}
+			os.Exit(_exit_success)
+		}
+
+		handleSubmittedTransaction(signedTx, log.Fields{
+			"group":        "nft",
+			"command":      "approve",
+			"token":        nftStr,
+			"tokenid":      tokenID.String(),
+			"tokenholder":  holderAddress.Hex(),
+			"tokenspender": spenderAddress.Hex(),
+		}, true)
+	},
+}
+
+func init() {
+	nftCmd.AddCommand(nftApproveCmd)
+	nftFlags(nftApproveCmd)
+	nftTokenIDFlag(nftApproveCmd)
+	nftApproveCmd.Flags().StringVar(&nftApproveHolderAddress, "holder", "", "Address that holds the token, or an operator for it")
+	nftApproveCmd.Flags().StringVar(&nftApproveSpenderAddress, "spender", "", "Address that can transfer the token")
+	nftApproveCmd.Flags().BoolVar(&nftApproveClear, "clear", false, "Remove the existing approval")
+	addTransactionFlags(nftApproveCmd, "the address from which to approve the token")
+}
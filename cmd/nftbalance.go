@@ -0,0 +1,63 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wealdtech/ethereal/cli"
+	ens "github.com/wealdtech/go-ens/v3"
+)
+
+var nftBalanceHolderAddress string
+
+// nftBalanceCmd represents the nft balance command
+var nftBalanceCmd = &cobra.Command{
+	Use:   "balance",
+	Short: "Obtain the number of NFTs held by an address",
+	Long: `Obtain the number of NFTs of a contract held by an address.  For example:
+
+    ethereal nft balance --token=0x06012c8cf97BEaD5deAe237070F9587f8E7A266d --holder=0x5FfC014343cd971B7eb70732021E26C35B744cc4
+
+In quiet mode this will return 0 if the balance is greater than 0, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli.Assert(nftBalanceHolderAddress != "", quiet, "--holder is required")
+		address, err := ens.Resolve(client, nftBalanceHolderAddress)
+		cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to resolve holder address %s", nftBalanceHolderAddress))
+
+		cli.Assert(nftStr != "", quiet, "--token is required")
+		token, err := nftContract(nftStr)
+		cli.ErrCheck(err, quiet, "Failed to obtain NFT contract")
+
+		balance, err := token.BalanceOf(nil, address)
+		cli.ErrCheck(err, quiet, "Failed to obtain NFT balance")
+
+		if quiet {
+			if balance.Sign() == 0 {
+				os.Exit(_exit_failure)
+			}
+			os.Exit(_exit_success)
+		}
+
+		fmt.Printf("%v\n", balance)
+	},
+}
+
+func init() {
+	nftFlags(nftBalanceCmd)
+	nftCmd.AddCommand(nftBalanceCmd)
+	nftBalanceCmd.Flags().StringVar(&nftBalanceHolderAddress, "holder", "", "Holder of NFTs")
+}
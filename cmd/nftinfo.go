@@ -0,0 +1,109 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wealdtech/ethereal/cli"
+	"github.com/wealdtech/ethereal/util"
+	"github.com/wealdtech/ethereal/util/output"
+	ens "github.com/wealdtech/go-ens/v3"
+)
+
+// nftInfoCmd represents the nft info command
+var nftInfoCmd = &cobra.Command{
+	Use:   "info",
+	Short: "Obtain information about an NFT contract",
+	Long: `Obtain information about an ERC-721 NFT contract, including the interfaces that it reports it supports through ERC-165.  For example:
+
+    ethereal nft info --token=0x06012c8cf97BEaD5deAe237070F9587f8E7A266d
+
+In quiet mode this will return 0 if the contract supports ERC-721, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli.Assert(nftStr != "", quiet, "--token is required")
+		address, err := nftContractAddress(nftStr)
+		cli.ErrCheck(err, quiet, "Failed to obtain NFT contract address")
+		token, err := nftContract(nftStr)
+		cli.ErrCheck(err, quiet, "Failed to obtain NFT contract")
+
+		ctx, cancel := localContext()
+		defer cancel()
+		erc721 := util.SupportsInterface(ctx, client, address, util.InterfaceIDERC721)
+		metadata := util.SupportsInterface(ctx, client, address, util.InterfaceIDERC721Metadata)
+		enumerable := util.SupportsInterface(ctx, client, address, util.InterfaceIDERC721Enumerable)
+
+		if quiet {
+			if erc721 {
+				os.Exit(_exit_success)
+			}
+			os.Exit(_exit_failure)
+		}
+
+		if structuredOutput() {
+			result := &output.NFTInfo{
+				Address:    address.Hex(),
+				ERC721:     erc721,
+				Metadata:   metadata,
+				Enumerable: enumerable,
+			}
+			result.Name, _ = token.Name(nil)
+			result.Symbol, _ = token.Symbol(nil)
+			totalSupply, err := token.TotalSupply(nil)
+			if err == nil {
+				result.TotalSupply = totalSupply.String()
+			}
+			outputResult(result)
+			os.Exit(_exit_success)
+		}
+
+		name, err := token.Name(nil)
+		if err == nil {
+			fmt.Printf("Name:\t\t%s\n", name)
+		}
+
+		if verbose {
+			fmt.Printf("Address:\t%s\n", ens.Format(client, address))
+		}
+
+		symbol, err := token.Symbol(nil)
+		if err == nil {
+			fmt.Printf("Symbol:\t\t%s\n", symbol)
+		}
+
+		totalSupply, err := token.TotalSupply(nil)
+		if err == nil {
+			fmt.Printf("Total supply:\t%v\n", totalSupply)
+		}
+
+		fmt.Printf("ERC-721:\t%s\n", supportedString(erc721))
+		fmt.Printf("Metadata:\t%s\n", supportedString(metadata))
+		fmt.Printf("Enumerable:\t%s\n", supportedString(enumerable))
+	},
+}
+
+// supportedString describes support for an interface.
+func supportedString(supported bool) string {
+	if supported {
+		return "supported"
+	}
+	return "not supported"
+}
+
+func init() {
+	nftFlags(nftInfoCmd)
+	nftCmd.AddCommand(nftInfoCmd)
+}
@@ -0,0 +1,56 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wealdtech/ethereal/cli"
+	ens "github.com/wealdtech/go-ens/v3"
+)
+
+// nftOwnerCmd represents the nft owner command
+var nftOwnerCmd = &cobra.Command{
+	Use:   "owner",
+	Short: "Obtain the owner of an NFT",
+	Long: `Obtain the owner of an NFT.  For example:
+
+    ethereal nft owner --token=0x06012c8cf97BEaD5deAe237070F9587f8E7A266d --tokenid=1
+
+In quiet mode this will return 0 if the token has an owner, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli.Assert(nftStr != "", quiet, "--token is required")
+		token, err := nftContract(nftStr)
+		cli.ErrCheck(err, quiet, "Failed to obtain NFT contract")
+		tokenID, err := nftTokenID()
+		cli.ErrCheck(err, quiet, "Invalid token ID")
+
+		owner, err := token.OwnerOf(nil, tokenID)
+		cli.ErrCheck(err, quiet, "Failed to obtain owner; does the token exist?")
+
+		if quiet {
+			os.Exit(_exit_success)
+		}
+
+		fmt.Printf("%s\n", ens.Format(client, owner))
+	},
+}
+
+func init() {
+	nftFlags(nftOwnerCmd)
+	nftTokenIDFlag(nftOwnerCmd)
+	nftCmd.AddCommand(nftOwnerCmd)
+}
@@ -0,0 +1,40 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// nftSafeTransferCmd represents the nft safetransfer command
+var nftSafeTransferCmd = &cobra.Command{
+	Use:   "safetransfer",
+	Short: "Safely transfer an NFT to a given address",
+	Long: `Transfer an NFT from one address to another with safeTransferFrom, which fails if the recipient is a contract that cannot accept the token.  For example:
+
+    ethereal nft safetransfer --token=0x06012c8cf97BEaD5deAe237070F9587f8E7A266d --tokenid=1 --from=0x5FfC014343cd971B7eb70732021E26C35B744cc4 --to=0x52f1A3027d3aA514F17E454C93ae1F79b3B12d5d --passphrase=secret
+
+The token is transferred from its current owner, so --from can be either the owner or an address approved to transfer the token.  Data supplied with --data is passed to the recipient.
+
+This will return an exit status of 0 if the transaction is successfully submitted (and mined if --wait is supplied), 1 if the transaction is not successfully submitted, and 2 if the transaction is successfully submitted but not mined within the supplied time limit.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		nftTransfer("safetransfer", true)
+	},
+}
+
+func init() {
+	nftCmd.AddCommand(nftSafeTransferCmd)
+	nftTransferFlags(nftSafeTransferCmd)
+	nftSafeTransferCmd.Flags().StringVar(&nftTransferData, "data", "", "data to pass to the recipient (as a hex string)")
+}
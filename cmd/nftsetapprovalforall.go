@@ -0,0 +1,96 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/wealdtech/ethereal/cli"
+	ens "github.com/wealdtech/go-ens/v3"
+)
+
+var nftSetApprovalForAllHolderAddress string
+var nftSetApprovalForAllOperatorAddress string
+var nftSetApprovalForAllApproved bool
+
+// nftSetApprovalForAllCmd represents the nft setapprovalforall command
+var nftSetApprovalForAllCmd = &cobra.Command{
+	Use:   "setapprovalforall",
+	Short: "Approve an operator to transfer all NFTs of a holder",
+	Long: `Approve or revoke an operator to transfer all NFTs of a contract held by an address.  For example:
+
+    ethereal nft setapprovalforall --token=0x06012c8cf97BEaD5deAe237070F9587f8E7A266d --holder=0x5FfC014343cd971B7eb70732021E26C35B744cc4 --operator=0x52f1A3027d3aA514F17E454C93ae1F79b3B12d5d --passphrase=secret
+
+Use --approved=false to revoke the operator's approval.
+
+This will return an exit status of 0 if the transaction is successfully submitted (and mined if --wait is supplied), 1 if the transaction is not successfully submitted, and 2 if the transaction is successfully submitted but not mined within the supplied time limit.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli.Assert(nftSetApprovalForAllHolderAddress != "", quiet, "--holder is required")
+		holderAddress, err := ens.Resolve(client, nftSetApprovalForAllHolderAddress)
+		cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to resolve holder address %s", nftSetApprovalForAllHolderAddress))
+
+		cli.Assert(nftSetApprovalForAllOperatorAddress != "", quiet, "--operator is required")
+		operatorAddress, err := ens.Resolve(client, nftSetApprovalForAllOperatorAddress)
+		cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to resolve operator address %s", nftSetApprovalForAllOperatorAddress))
+		cli.Assert(operatorAddress != holderAddress, quiet, "Holder cannot be its own operator")
+
+		cli.Assert(nftStr != "", quiet, "--token is required")
+		token, err := nftContract(nftStr)
+		cli.ErrCheck(err, quiet, "Failed to obtain NFT contract")
+
+		if !offline {
+			approved, err := token.IsApprovedForAll(nil, holderAddress, operatorAddress)
+			cli.ErrCheck(err, quiet, "Failed to obtain operator approval")
+			cli.Assert(approved != nftSetApprovalForAllApproved, quiet, fmt.Sprintf("Operator approval is already %t", approved))
+		}
+
+		opts, err := generateTxOpts(holderAddress)
+		cli.ErrCheck(err, quiet, "Failed to generate transaction options")
+
+		signedTx, err := token.SetApprovalForAll(opts, operatorAddress, nftSetApprovalForAllApproved)
+		cli.ErrCheck(err, quiet, "Failed to create transaction")
+
+		if offline {
+			if !quiet {
+				data, err := signedTx.MarshalBinary()
+				cli.ErrCheck(err, quiet, "Failed to marshal transaction")
+				fmt.Printf("0x%s\n", hex.EncodeToString(data))
+			}
+			os.Exit(_exit_success)
+		}
+
+		handleSubmittedTransaction(signedTx, log.Fields{
+			"group":         "nft",
+			"command":       "setapprovalforall",
+			"token":         nftStr,
+			"tokenholder":   holderAddress.Hex(),
+			"tokenoperator": operatorAddress.Hex(),
+			"approved":      strconv.FormatBool(nftSetApprovalForAllApproved),
+		}, true)
+	},
+}
+
+func init() {
+	nftCmd.AddCommand(nftSetApprovalForAllCmd)
+	nftFlags(nftSetApprovalForAllCmd)
+	nftSetApprovalForAllCmd.Flags().StringVar(&nftSetApprovalForAllHolderAddress, "holder", "", "Address that holds the tokens")
+	nftSetApprovalForAllCmd.Flags().StringVar(&nftSetApprovalForAllOperatorAddress, "operator", "", "Address that can transfer the tokens")
+	nftSetApprovalForAllCmd.Flags().BoolVar(&nftSetApprovalForAllApproved, "approved", true, "Approve the operator; false to revoke its approval")
+	addTransactionFlags(nftSetApprovalForAllCmd, "the address from which to approve the operator")
+}
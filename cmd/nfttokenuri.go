@@ -0,0 +1,84 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wealdtech/ethereal/cli"
+	"github.com/wealdtech/ethereal/util"
+)
+
+var nftTokenURIRaw bool
+
+// nftTokenURICmd represents the nft tokenuri command
+var nftTokenURICmd = &cobra.Command{
+	Use:   "tokenuri",
+	Short: "Obtain the metadata URI of an NFT",
+	Long: `Obtain the metadata URI of an NFT.  For example:
+
+    ethereal nft tokenuri --token=0x06012c8cf97BEaD5deAe237070F9587f8E7A266d --tokenid=1
+
+Metadata held on-chain as a data: URI is decoded, and JSON metadata is formatted for display.  Use --raw to show the URI as returned by the contract.
+
+In quiet mode this will return 0 if the token has a URI, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli.Assert(nftStr != "", quiet, "--token is required")
+		token, err := nftContract(nftStr)
+		cli.ErrCheck(err, quiet, "Failed to obtain NFT contract")
+		tokenID, err := nftTokenID()
+		cli.ErrCheck(err, quiet, "Invalid token ID")
+
+		uri, err := token.TokenURI(nil, tokenID)
+		cli.ErrCheck(err, quiet, "Failed to obtain token URI")
+
+		if quiet {
+			if uri == "" {
+				os.Exit(_exit_failure)
+			}
+			os.Exit(_exit_success)
+		}
+
+		if nftTokenURIRaw || !util.IsDataURI(uri) {
+			fmt.Printf("%s\n", uri)
+			os.Exit(_exit_success)
+		}
+		fmt.Printf("%s\n", decodeMetadataURI(uri))
+	},
+}
+
+// decodeMetadataURI decodes metadata held in a data: URI for display.
+func decodeMetadataURI(uri string) string {
+	mediaType, data, err := util.DecodeDataURI(uri)
+	cli.ErrCheck(err, quiet, "Failed to decode token URI")
+	outputIf(verbose, fmt.Sprintf("Media type is %s", mediaType))
+	if mediaType == "application/json" || json.Valid(data) {
+		var formatted bytes.Buffer
+		if err := json.Indent(&formatted, data, "", "  "); err == nil {
+			return formatted.String()
+		}
+	}
+	return string(data)
+}
+
+func init() {
+	nftFlags(nftTokenURICmd)
+	nftTokenIDFlag(nftTokenURICmd)
+	nftCmd.AddCommand(nftTokenURICmd)
+	nftTokenURICmd.Flags().BoolVar(&nftTokenURIRaw, "raw", false, "Display the URI without decoding it")
+}
@@ -0,0 +1,141 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/wealdtech/ethereal/cli"
+	ens "github.com/wealdtech/go-ens/v3"
+)
+
+var nftTransferFromAddress string
+var nftTransferToAddress string
+var nftTransferData string
+
+// nftTransferCmd represents the nft transfer command
+var nftTransferCmd = &cobra.Command{
+	Use:   "transfer",
+	Short: "Transfer an NFT to a given address",
+	Long: `Transfer an NFT from one address to another with transferFrom.  For example:
+
+    ethereal nft transfer --token=0x06012c8cf97BEaD5deAe237070F9587f8E7A266d --tokenid=1 --from=0x5FfC014343cd971B7eb70732021E26C35B744cc4 --to=0x52f1A3027d3aA514F17E454C93ae1F79b3B12d5d --passphrase=secret
+
+The token is transferred from its current owner, so --from can be either the owner or an address approved to transfer the token.  An NFT transferred to a contract that cannot handle it may be lost; 'nft safetransfer' checks that the recipient can accept the token.
+
+This will return an exit status of 0 if the transaction is successfully submitted (and mined if --wait is supplied), 1 if the transaction is not successfully submitted, and 2 if the transaction is successfully submitted but not mined within the supplied time limit.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		nftTransfer("transfer", false)
+	},
+}
+
+// nftTransfer transfers an NFT, with safeTransferFrom if safe is true or
+// transferFrom otherwise, and exits.
+func nftTransfer(command string, safe bool) {
+	cli.Assert(nftTransferFromAddress != "", quiet, "--from is required")
+	fromAddress, err := ens.Resolve(client, nftTransferFromAddress)
+	cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to resolve from address %s", nftTransferFromAddress))
+
+	cli.Assert(nftTransferToAddress != "", quiet, "--to is required")
+	toAddress, err := ens.Resolve(client, nftTransferToAddress)
+	cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to resolve to address %s", nftTransferToAddress))
+
+	cli.Assert(nftStr != "", quiet, "--token is required")
+	token, err := nftContract(nftStr)
+	cli.ErrCheck(err, quiet, "Failed to obtain NFT contract")
+	tokenID, err := nftTokenID()
+	cli.ErrCheck(err, quiet, "Invalid token ID")
+
+	var data []byte
+	if nftTransferData != "" {
+		data, err = hex.DecodeString(strings.TrimPrefix(nftTransferData, "0x"))
+		cli.ErrCheck(err, quiet, "Failed to parse data")
+	}
+
+	// The token is transferred from its owner, which is assumed to be the
+	// sender if offline.
+	ownerAddress := fromAddress
+	if !offline {
+		ownerAddress, err = token.OwnerOf(nil, tokenID)
+		cli.ErrCheck(err, quiet, "Failed to obtain owner; does the token exist?")
+		if ownerAddress != fromAddress {
+			approved, err := token.GetApproved(nil, tokenID)
+			cli.ErrCheck(err, quiet, "Failed to obtain approved address")
+			approvedForAll, err := token.IsApprovedForAll(nil, ownerAddress, fromAddress)
+			cli.ErrCheck(err, quiet, "Failed to obtain operator approval")
+			cli.Assert(approved == fromAddress || approvedForAll, quiet, fmt.Sprintf("%s is neither the owner of the token nor approved to transfer it", ens.Format(client, fromAddress)))
+		}
+		if !safe {
+			ctx, cancel := localContext()
+			defer cancel()
+			code, err := client.CodeAt(ctx, toAddress, nil)
+			cli.ErrCheck(err, quiet, "Failed to obtain code of recipient")
+			if len(code) > 0 {
+				outputIf(!quiet, "Recipient is a contract; if it cannot handle NFTs the token will be lost.  Consider using 'nft safetransfer'")
+			}
+		}
+	}
+
+	opts, err := generateTxOpts(fromAddress)
+	cli.ErrCheck(err, quiet, "Failed to generate transaction options")
+
+	var signedTx *types.Transaction
+	switch {
+	case !safe:
+		signedTx, err = token.TransferFrom(opts, ownerAddress, toAddress, tokenID)
+	case len(data) > 0:
+		signedTx, err = token.SafeTransferFrom0(opts, ownerAddress, toAddress, tokenID, data)
+	default:
+		signedTx, err = token.SafeTransferFrom(opts, ownerAddress, toAddress, tokenID)
+	}
+	cli.ErrCheck(err, quiet, "Failed to create transaction")
+
+	if offline {
+		if !quiet {
+			data, err := signedTx.MarshalBinary()
+			cli.ErrCheck(err, quiet, "Failed to marshal transaction")
+			fmt.Printf("0x%s\n", hex.EncodeToString(data))
+		}
+		os.Exit(_exit_success)
+	}
+
+	handleSubmittedTransaction(signedTx, log.Fields{
+		"group":          "nft",
+		"command":        command,
+		"token":          nftStr,
+		"tokenid":        tokenID.String(),
+		"tokenholder":    ownerAddress.Hex(),
+		"tokensender":    fromAddress.Hex(),
+		"tokenrecipient": toAddress.Hex(),
+	}, true)
+}
+
+func nftTransferFlags(cmd *cobra.Command) {
+	nftFlags(cmd)
+	nftTokenIDFlag(cmd)
+	cmd.Flags().StringVar(&nftTransferFromAddress, "from", "", "Address from which to transfer the token, either its owner or an approved address")
+	cmd.Flags().StringVar(&nftTransferToAddress, "to", "", "Address to which to transfer the token")
+	addTransactionFlags(cmd, "the address from which to transfer the token")
+}
+
+func init() {
+	nftCmd.AddCommand(nftTransferCmd)
+	nftTransferFlags(nftTransferCmd)
+}
@@ -19,8 +19,9 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/wealdtech/ethereal/cli"
-	ens "github.com/wealdtech/go-ens/v2"
-	erc1820 "github.com/wealdtech/go-erc1820"
+	"github.com/wealdtech/ethereal/util/erc1820"
+	"github.com/wealdtech/ethereal/util/output"
+	ens "github.com/wealdtech/go-ens/v3"
 )
 
 // registryImplementerGetCmd represents the registry implementer get command
@@ -45,6 +46,18 @@ In quiet mode this will return 0 if the implementer has an address, otherwise 1.
 		implementer, err := registry.InterfaceImplementer(registryImplementerInterface, &address)
 		cli.ErrCheck(err, quiet, "failed to obtain implementer")
 
+		if structuredOutput() {
+			outputResult(&output.RegistryImplementer{
+				Address:     address.Hex(),
+				Interface:   registryImplementerInterface,
+				Implementer: implementer.Hex(),
+			})
+			if *implementer == ens.UnknownAddress {
+				os.Exit(_exit_failure)
+			}
+			os.Exit(_exit_success)
+		}
+
 		if *implementer == ens.UnknownAddress {
 			os.Exit(_exit_failure)
 		}
@@ -17,8 +17,8 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/wealdtech/ethereal/cli"
-	ens "github.com/wealdtech/go-ens/v2"
-	erc1820 "github.com/wealdtech/go-erc1820"
+	"github.com/wealdtech/ethereal/util/erc1820"
+	ens "github.com/wealdtech/go-ens/v3"
 )
 
 var registryImplementerSetImplementerStr string
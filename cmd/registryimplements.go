@@ -20,8 +20,9 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/cobra"
 	"github.com/wealdtech/ethereal/cli"
-	ens "github.com/wealdtech/go-ens/v2"
-	erc1820 "github.com/wealdtech/go-erc1820"
+	"github.com/wealdtech/ethereal/util/erc1820"
+	"github.com/wealdtech/ethereal/util/output"
+	ens "github.com/wealdtech/go-ens/v3"
 )
 
 var registryImplementsInterface string
@@ -51,7 +52,13 @@ In quiet mode this will return 0 if the address implements the interface, otherw
 		implementsInterface, err := implementer.ImplementsInterface(registryImplementsInterface, &anyone)
 		cli.ErrCheck(err, quiet, "failed to obtain implementation status")
 
-		if !quiet {
+		if structuredOutput() {
+			outputResult(&output.RegistryImplements{
+				Address:    address.Hex(),
+				Interface:  registryImplementsInterface,
+				Implements: implementsInterface,
+			})
+		} else if !quiet {
 			if implementsInterface {
 				fmt.Println("Yes")
 			} else {
@@ -19,8 +19,9 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/wealdtech/ethereal/cli"
-	ens "github.com/wealdtech/go-ens/v2"
-	erc1820 "github.com/wealdtech/go-erc1820"
+	"github.com/wealdtech/ethereal/util/erc1820"
+	"github.com/wealdtech/ethereal/util/output"
+	ens "github.com/wealdtech/go-ens/v3"
 )
 
 // registryManagerGetCmd represents the registry manager get command
@@ -49,7 +50,12 @@ In quiet mode this will return 0 if the manager was obtained without error, othe
 			manager = &address
 		}
 
-		if !quiet {
+		if structuredOutput() {
+			outputResult(&output.RegistryManager{
+				Address: address.Hex(),
+				Manager: manager.Hex(),
+			})
+		} else if !quiet {
 			fmt.Printf("%s\n", ens.Format(client, *manager))
 		}
 		os.Exit(_exit_success)
@@ -17,6 +17,7 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
@@ -28,16 +29,22 @@ import (
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/external"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 	homedir "github.com/mitchellh/go-homedir"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/wealdtech/ethereal/cli"
 	"github.com/wealdtech/ethereal/util"
+	"github.com/wealdtech/ethereal/util/output"
+	"github.com/wealdtech/ethereal/util/txdata"
+	ens "github.com/wealdtech/go-ens/v3"
 	string2eth "github.com/wealdtech/go-string2eth"
 )
 
@@ -47,18 +54,57 @@ var verbose bool
 var debug bool
 var offline bool
 
+// Format of output for commands that obtain information
+var outputFormat output.Format
+
 var client *ethclient.Client
+var rpcClient *rpc.Client
 var chainID *big.Int
 var referrer common.Address
 
 var nonce int64
+var autoNonce bool
 var wallet accounts.Wallet
 var account *accounts.Account
 
+// External signer
+var externalSigner *external.ExternalSigner
+
+// Nonces reserved from the nonce store for transactions that have yet to be
+// sent
+var unsentNonces []reservedNonce
+
+type reservedNonce struct {
+	store *util.NonceStore
+	nonce uint64
+}
+
 // Common variables
 var gasPrice *big.Int
 var gasLimit uint64
 
+// Dynamic fee variables
+var maxFeePerGas *big.Int
+var maxPriorityFeePerGas *big.Int
+var dynamicFees bool
+var feesConfigured bool
+
+// defaultGasPrice is the gas price used for legacy transactions when none is supplied
+var defaultGasPrice = big.NewInt(4000000000)
+
+// Access list
+var accessList types.AccessList
+var accessListAuto bool
+
+// Output unsigned transactions
+var unsigned bool
+
+// Simulate transactions
+var simulate bool
+
+// Escalate fees of transactions until mined
+var escalateCap *big.Int
+
 var err error
 
 // Commands that can be run offline
@@ -114,6 +160,9 @@ func persistentPreRun(cmd *cobra.Command, args []string) {
 		cli.Err(false, "Cannot supply both quiet and debug flags")
 	}
 
+	outputFormat, err = output.ParseFormat(viper.GetString("output"))
+	cli.ErrCheck(err, false, "Invalid output format")
+
 	// ...lots of commands have transaction-related flags (e.g.) 'passphrase'
 	// as options but we want to bind them to this particular command and
 	// this is the first chance we get
@@ -123,6 +172,13 @@ func persistentPreRun(cmd *cobra.Command, args []string) {
 	if cmd.Flags().Lookup("privatekey") != nil {
 		viper.BindPFlag("privatekey", cmd.Flags().Lookup("privatekey"))
 	}
+	if cmd.Flags().Lookup("mnemonic") != nil {
+		viper.BindPFlag("mnemonic", cmd.Flags().Lookup("mnemonic"))
+	}
+	if cmd.Flags().Lookup("path") != nil {
+		// Bound as 'hdpath' as 'path' would pick up $PATH from the environment.
+		viper.BindPFlag("hdpath", cmd.Flags().Lookup("path"))
+	}
 	if cmd.Flags().Lookup("nonce") != nil {
 		viper.BindPFlag("nonce", cmd.Flags().Lookup("nonce"))
 	}
@@ -135,13 +191,14 @@ func persistentPreRun(cmd *cobra.Command, args []string) {
 	if cmd.Flags().Lookup("limit") != nil {
 		viper.BindPFlag("limit", cmd.Flags().Lookup("limit"))
 	}
+	if cmd.Flags().Lookup("confirmations") != nil {
+		viper.BindPFlag("confirmations", cmd.Flags().Lookup("confirmations"))
+		cli.Assert(viper.GetUint64("confirmations") > 0, quiet, "Confirmations must be at least 1")
+	}
 	// Set up gas price if we have it
 	if cmd.Flags().Lookup("gasprice") != nil {
 		viper.BindPFlag("gasprice", cmd.Flags().Lookup("gasprice"))
-		if viper.GetString("gasprice") == "" {
-			gasPrice, err = string2eth.StringToWei("4 GWei")
-			cli.ErrCheck(err, quiet, "Invalid gas price")
-		} else {
+		if viper.GetString("gasprice") != "" {
 			if strings.Contains(viper.GetString("gasprice"), "block") {
 				// Block-based gas price
 				outputIf(verbose, fmt.Sprintf("xx"))
@@ -158,9 +215,71 @@ func persistentPreRun(cmd *cobra.Command, args []string) {
 			}
 		}
 	}
+	// Set up dynamic fees if we have them
+	if cmd.Flags().Lookup("maxfeepergas") != nil {
+		viper.BindPFlag("maxfeepergas", cmd.Flags().Lookup("maxfeepergas"))
+		if viper.GetString("maxfeepergas") != "" {
+			maxFeePerGas, err = string2eth.StringToWei(viper.GetString("maxfeepergas"))
+			cli.ErrCheck(err, quiet, "Invalid max fee per gas")
+		}
+	}
+	if cmd.Flags().Lookup("maxpriorityfeepergas") != nil {
+		viper.BindPFlag("maxpriorityfeepergas", cmd.Flags().Lookup("maxpriorityfeepergas"))
+		if viper.GetString("maxpriorityfeepergas") != "" {
+			maxPriorityFeePerGas, err = string2eth.StringToWei(viper.GetString("maxpriorityfeepergas"))
+			cli.ErrCheck(err, quiet, "Invalid max priority fee per gas")
+		}
+	}
+	if gasPrice != nil && (maxFeePerGas != nil || maxPriorityFeePerGas != nil) {
+		cli.Err(quiet, "Cannot supply both gas price and dynamic fee flags")
+	}
+
+	// Set up access list if we have it
+	if cmd.Flags().Lookup("accesslist") != nil {
+		viper.BindPFlag("accesslist", cmd.Flags().Lookup("accesslist"))
+		if viper.GetString("accesslist") == "auto" {
+			cli.Assert(!offline, quiet, "Cannot generate an access list when offline")
+			accessListAuto = true
+		} else if viper.GetString("accesslist") != "" {
+			accessList, err = util.ParseAccessList(viper.GetString("accesslist"))
+			cli.ErrCheck(err, quiet, "Failed to parse access list")
+		}
+	}
+
+	if cmd.Flags().Lookup("unsigned") != nil {
+		viper.BindPFlag("unsigned", cmd.Flags().Lookup("unsigned"))
+		unsigned = viper.GetBool("unsigned")
+	}
+	if cmd.Flags().Lookup("simulate") != nil {
+		viper.BindPFlag("simulate", cmd.Flags().Lookup("simulate"))
+		simulate = viper.GetBool("simulate")
+		cli.Assert(!(simulate && offline), quiet, "Cannot simulate a transaction when offline")
+		cli.Assert(!(simulate && unsigned), quiet, "Cannot supply both simulate and unsigned flags")
+	}
+
+	// Set up escalation if we have it
+	if cmd.Flags().Lookup("escalate") != nil {
+		viper.BindPFlag("escalate", cmd.Flags().Lookup("escalate"))
+	}
+	if cmd.Flags().Lookup("escalatestep") != nil {
+		viper.BindPFlag("escalatestep", cmd.Flags().Lookup("escalatestep"))
+		cli.Assert(viper.GetInt("escalatestep") >= 10, quiet, "Escalation step must be at least 10%")
+	}
+	if cmd.Flags().Lookup("escalateinterval") != nil {
+		viper.BindPFlag("escalateinterval", cmd.Flags().Lookup("escalateinterval"))
+		cli.Assert(viper.GetDuration("escalateinterval") > 0, quiet, "Escalation interval must be greater than 0")
+	}
+	if cmd.Flags().Lookup("escalatecap") != nil {
+		viper.BindPFlag("escalatecap", cmd.Flags().Lookup("escalatecap"))
+		if viper.GetString("escalatecap") != "" {
+			escalateCap, err = string2eth.StringToWei(viper.GetString("escalatecap"))
+			cli.ErrCheck(err, quiet, "Invalid escalation cap")
+		}
+	}
 
 	// Set up nonce if we have it
 	nonce = viper.GetInt64("nonce")
+	autoNonce = nonce == -1
 
 	if cmd.Flags().Lookup("gaslimit") != nil {
 		viper.BindPFlag("gaslimit", cmd.Flags().Lookup("gaslimit"))
@@ -179,31 +298,34 @@ func persistentPreRun(cmd *cobra.Command, args []string) {
 // connect connects to an Ethereum node
 func connect() error {
 	var err error
+	var connection string
 	if viper.GetString("connection") != "" {
 		outputIf(debug, fmt.Sprintf("Connecting to %s", viper.GetString("connection")))
-		client, err = ethclient.Dial(viper.GetString("connection"))
+		connection = viper.GetString("connection")
 	} else {
 		switch viper.GetString("network") {
 		case "mainnet":
 			outputIf(debug, "Connecting to mainnet")
-			client, err = ethclient.Dial("https://mainnet.infura.io/v3/831a5442dc2e4536a9f8dee4ea1707a6")
+			connection = "https://mainnet.infura.io/v3/831a5442dc2e4536a9f8dee4ea1707a6"
 		case "ropsten":
 			outputIf(debug, "Connecting to ropsten")
-			client, err = ethclient.Dial("https://ropsten.infura.io/v3/831a5442dc2e4536a9f8dee4ea1707a6")
+			connection = "https://ropsten.infura.io/v3/831a5442dc2e4536a9f8dee4ea1707a6"
 		case "rinkeby":
 			outputIf(debug, "Connecting to rinkeby")
-			client, err = ethclient.Dial("https://rinkeby.infura.io/v3/831a5442dc2e4536a9f8dee4ea1707a6")
+			connection = "https://rinkeby.infura.io/v3/831a5442dc2e4536a9f8dee4ea1707a6"
 		case "goerli", "gorli", "görli":
 			outputIf(debug, "Connecting to goerli")
-			client, err = ethclient.Dial("https://goerli.infura.io/v3/831a5442dc2e4536a9f8dee4ea1707a6")
+			connection = "https://goerli.infura.io/v3/831a5442dc2e4536a9f8dee4ea1707a6"
 		case "kovan":
 			outputIf(debug, "Connecting to kovan")
-			client, err = ethclient.Dial("http://35.178.1.16")
+			connection = "http://35.178.1.16"
 		default:
 			cli.Err(quiet, fmt.Sprintf("Unknown network %s", viper.GetString("network")))
 		}
 	}
+	rpcClient, err = rpc.Dial(connection)
 	cli.ErrCheck(err, quiet, "Failed to connect to network")
+	client = ethclient.NewClient(rpcClient)
 	// Fetch the chain ID
 	ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration("timeout"))
 	defer cancel()
@@ -239,6 +361,8 @@ func setupLogging() {
 // If exit is false this function will return false if asked to wait and the transaction is not
 // mined, otherwise true.
 func handleSubmittedTransaction(tx *types.Transaction, logFields log.Fields, exit bool) bool {
+	nonceSent(tx.Nonce())
+
 	if logFields != nil {
 		logTransaction(tx, logFields)
 	}
@@ -251,9 +375,30 @@ func handleSubmittedTransaction(tx *types.Transaction, logFields log.Fields, exi
 			return true
 		}
 	}
-	mined := util.WaitForTransaction(client, tx.Hash(), viper.GetDuration("limit"))
+	var receipt *types.Receipt
+	var mined bool
+	if viper.GetBool("escalate") {
+		receipt, mined = escalateTransaction(tx, logFields)
+	} else {
+		receipt, mined = util.WaitForTransaction(client, tx.Hash(), viper.GetUint64("confirmations"), viper.GetDuration("limit"))
+	}
 	if mined {
-		outputIf(!quiet, fmt.Sprintf("%s mined", tx.Hash().Hex()))
+		replacing := ""
+		if receipt.TxHash != tx.Hash() {
+			replacing = fmt.Sprintf(" (replacing %s)", tx.Hash().Hex())
+		}
+		if receipt.Status == types.ReceiptStatusFailed {
+			outputIf(!quiet, fmt.Sprintf("%s mined but failed%s", receipt.TxHash.Hex(), replacing))
+		} else {
+			outputIf(!quiet, fmt.Sprintf("%s mined%s", receipt.TxHash.Hex(), replacing))
+		}
+		outputReceipt(receipt)
+		if receipt.Status == types.ReceiptStatusFailed {
+			if exit {
+				os.Exit(_exit_failure)
+			}
+			return false
+		}
 		if exit {
 			os.Exit(_exit_success)
 		} else {
@@ -267,6 +412,19 @@ func handleSubmittedTransaction(tx *types.Transaction, logFields log.Fields, exi
 	return false
 }
 
+// outputReceipt outputs details of a mined transaction in verbose mode.
+func outputReceipt(receipt *types.Receipt) {
+	if !verbose {
+		return
+	}
+	fmt.Printf("Block:\t\t%v\n", receipt.BlockNumber)
+	fmt.Printf("Block hash:\t%s\n", receipt.BlockHash.Hex())
+	fmt.Printf("Gas used:\t%d\n", receipt.GasUsed)
+	if receipt.ContractAddress != (common.Address{}) {
+		fmt.Printf("Contract:\t%s\n", receipt.ContractAddress.Hex())
+	}
+}
+
 // logTransaction logs a transaction
 func logTransaction(tx *types.Transaction, fields log.Fields) {
 	setupLogging()
@@ -274,11 +432,17 @@ func logTransaction(tx *types.Transaction, fields log.Fields) {
 	txFields := log.Fields{
 		"networkid":     chainID,
 		"transactionid": tx.Hash().Hex(),
+		"type":          tx.Type(),
 		"gas":           tx.Gas(),
-		"gasprice":      tx.GasPrice().String(),
 		"value":         tx.Value().String(),
 		"data":          hex.EncodeToString(tx.Data()),
 	}
+	if tx.Type() == types.DynamicFeeTxType {
+		txFields["maxfeepergas"] = tx.GasFeeCap().String()
+		txFields["maxpriorityfeepergas"] = tx.GasTipCap().String()
+	} else {
+		txFields["gasprice"] = tx.GasPrice().String()
+	}
 	fromAddress, err := txFrom(tx)
 	if err == nil {
 		txFields["from"] = fromAddress.Hex()
@@ -301,6 +465,7 @@ func Execute() {
 
 func init() {
 	cobra.OnInitialize(initConfig)
+	cli.OnErrorExit(releaseUnsentNonces)
 
 	RootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.ethereal.yaml)")
 	RootCmd.PersistentFlags().String("log", "", "log activity to the named file (default $HOME/ethereal.log).  Logs are written for every action that generates a transaction")
@@ -311,6 +476,8 @@ func init() {
 	viper.BindPFlag("verbose", RootCmd.PersistentFlags().Lookup("verbose"))
 	RootCmd.PersistentFlags().Bool("debug", false, "generate debug output")
 	viper.BindPFlag("debug", RootCmd.PersistentFlags().Lookup("debug"))
+	RootCmd.PersistentFlags().String("output", "text", "format of output for commands that obtain information (text/json/yaml/table)")
+	viper.BindPFlag("output", RootCmd.PersistentFlags().Lookup("output"))
 	RootCmd.PersistentFlags().String("connection", "", "the custom IPC or RPC path to an Ethereum node (overrides network option).  If you are running your own local instance of Ethereum this might be /home/user/.ethereum/geth.ipc (IPC) or http://localhost:8545/ (RPC)")
 	viper.BindPFlag("connection", RootCmd.PersistentFlags().Lookup("connection"))
 	RootCmd.PersistentFlags().String("network", "mainnet", "network to access (mainnet/ropsten/kovan/rinkeby/goerli/volta/energyweb) (overridden by connection option)")
@@ -321,6 +488,10 @@ func init() {
 	viper.BindPFlag("offline", RootCmd.PersistentFlags().Lookup("offline"))
 	RootCmd.PersistentFlags().Int("usbwallets", 1, "number of USB wallets to show")
 	viper.BindPFlag("usbwallets", RootCmd.PersistentFlags().Lookup("usbwallets"))
+	RootCmd.PersistentFlags().String("datadir", "", "directory in which to store data such as reserved nonces (default $HOME/.ethereal)")
+	viper.BindPFlag("datadir", RootCmd.PersistentFlags().Lookup("datadir"))
+	RootCmd.PersistentFlags().String("external-signer", "", "URL or IPC path of an external signer such as Clef, used to sign in place of local keys")
+	viper.BindPFlag("external-signer", RootCmd.PersistentFlags().Lookup("external-signer"))
 }
 
 // initConfig reads in config file and ENV variables if set.
@@ -351,59 +522,187 @@ func initConfig() {
 // Helpers
 //
 
+// Add flags for commands that sign with a key derived from a mnemonic
+func addMnemonicFlags(cmd *cobra.Command, explanation string) {
+	cmd.Flags().String("mnemonic", "", fmt.Sprintf("BIP-39 mnemonic for %s; --passphrase, if supplied, is the mnemonic's passphrase", explanation))
+	cmd.Flags().String("path", util.DefaultHDPath, fmt.Sprintf("BIP-32 derivation path of the key for %s when using --mnemonic", explanation))
+}
+
 // Add flags for commands that carry out transactions
 func addTransactionFlags(cmd *cobra.Command, explanation string) {
 	cmd.Flags().String("passphrase", "", fmt.Sprintf("passphrase for %s", explanation))
 	cmd.Flags().String("privatekey", "", fmt.Sprintf("private key for %s", explanation))
-	cmd.Flags().String("gasprice", "", "Gas price for the transaction")
+	addMnemonicFlags(cmd, explanation)
+	cmd.Flags().String("gasprice", "", "Gas price for the transaction; creates a legacy transaction")
+	cmd.Flags().String("maxfeepergas", "", "Maximum fee per gas for the transaction; default is twice the current base fee plus the priority fee")
+	cmd.Flags().String("maxpriorityfeepergas", "", "Maximum priority fee per gas for the transaction; default is the node's suggested priority fee")
 	cmd.Flags().String("value", "", "Ether to send with the transaction")
 	cmd.Flags().Int64("gaslimit", 0, "Gas limit for the transaction; 0 is auto-select")
 	cmd.Flags().Int64("nonce", -1, "Nonce for the transaction; -1 is auto-select")
 	cmd.Flags().Bool("wait", false, "wait for the transaction to be mined before returning")
 	cmd.Flags().Duration("limit", 0, "maximum time to wait for transaction to complete before failing (default forever)")
+	cmd.Flags().Uint64("confirmations", 1, "when waiting, number of blocks in which the transaction must be included before it is considered mined")
+	cmd.Flags().Bool("escalate", false, "when waiting, replace the transaction with one paying higher fees if it is not mined in time")
+	addEscalationFlags(cmd)
+	cmd.Flags().Bool("unsigned", false, "print the transaction as unsigned JSON for signing with 'transaction sign' rather than signing and sending it")
+	cmd.Flags().Bool("simulate", false, "simulate the transaction against the current state and print its effects rather than sending it")
+}
+
+// Add flags for commands that escalate transaction fees
+func addEscalationFlags(cmd *cobra.Command) {
+	cmd.Flags().Int("escalatestep", 15, "percentage by which to increase fees each time a transaction is escalated")
+	cmd.Flags().Duration("escalateinterval", time.Minute, "time to wait for a transaction to be mined before escalating it")
+	cmd.Flags().String("escalatecap", "", "maximum gas price or max fee per gas to which a transaction can be escalated")
+}
+
+// Add access list flag for commands that create their own transactions
+func addAccessListFlag(cmd *cobra.Command) {
+	cmd.Flags().String("accesslist", "", "Access list for the transaction, as JSON or the name of a file containing JSON; \"auto\" to have the node generate it")
 }
 
-// Obtain the current nonce for the given address
+// Obtain the current nonce for the given address.  If the nonce has not been
+// supplied then it is reserved from the local nonce store, to avoid clashing
+// with other concurrent instances of Ethereal.
 func currentNonce(address common.Address) (uint64, error) {
-	var currentNonce uint64
 	if nonce == -1 {
-		if client == nil {
-			err := connect()
+		chainNonce, err := pendingNonce(address)
+		if err != nil {
+			return 0, err
+		}
+		store, err := nonceStore(address)
+		if err != nil {
+			outputIf(debug, fmt.Sprintf("Nonce store unavailable (%v); using nonce from node", err))
+			nonce = int64(chainNonce)
+		} else {
+			var storeNonce uint64
+			if simulate {
+				// Simulations do not use the nonce
+				storeNonce, err = store.Peek(chainNonce)
+			} else {
+				storeNonce, err = store.Reserve(chainNonce, 1)
+			}
 			if err != nil {
-				return 0, err
+				return 0, fmt.Errorf("failed to reserve nonce for %s: %v", address.Hex(), err)
+			}
+			if !simulate {
+				trackReservedNonces(store, storeNonce, 1)
+			}
+			if storeNonce != chainNonce {
+				outputIf(debug, fmt.Sprintf("Node nonce is %d; using nonce %d from nonce store", chainNonce, storeNonce))
 			}
+			nonce = int64(storeNonce)
 		}
+	}
+	return uint64(nonce), nil
+}
 
-		var tmpNonce uint64
-		ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration("timeout"))
-		defer cancel()
-		tmpNonce, err = client.PendingNonceAt(ctx, address)
-		if err != nil {
-			return 0, fmt.Errorf("failed to obtain nonce for %s: %v", address.Hex(), err)
+// Reserve count consecutive nonces for the given address, returning the first
+// of them.  If the nonce has been supplied then the nonces start from it.
+func reserveNonces(address common.Address, count uint64) (uint64, error) {
+	if nonce != -1 {
+		return uint64(nonce), nil
+	}
+	chainNonce, err := pendingNonce(address)
+	if err != nil {
+		return 0, err
+	}
+	store, err := nonceStore(address)
+	if err != nil {
+		outputIf(debug, fmt.Sprintf("Nonce store unavailable (%v); using nonce from node", err))
+		return chainNonce, nil
+	}
+	first, err := store.Reserve(chainNonce, count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to reserve nonces for %s: %v", address.Hex(), err)
+	}
+	trackReservedNonces(store, first, count)
+	return first, nil
+}
+
+// trackReservedNonces records nonces reserved from the nonce store, so that
+// they can be released if the command fails before sending the transactions
+// that use them.
+func trackReservedNonces(store *util.NonceStore, first uint64, count uint64) {
+	for i := uint64(0); i < count; i++ {
+		unsentNonces = append(unsentNonces, reservedNonce{store: store, nonce: first + i})
+	}
+}
+
+// nonceSent records that a transaction using a reserved nonce has been sent.
+func nonceSent(nonce uint64) {
+	for i := range unsentNonces {
+		if unsentNonces[i].nonce == nonce {
+			unsentNonces = append(unsentNonces[:i], unsentNonces[i+1:]...)
+			return
+		}
+	}
+}
+
+// releaseUnsentNonces returns reserved nonces that have not been used by a
+// sent transaction to the nonce store, so that they do not leave a gap.
+func releaseUnsentNonces() {
+	// Release the highest nonce first, as the store only releases the most
+	// recently reserved nonce.
+	for i := len(unsentNonces) - 1; i >= 0; i-- {
+		if err := unsentNonces[i].store.Release(unsentNonces[i].nonce); err != nil {
+			outputIf(debug, fmt.Sprintf("Failed to release nonce %d: %v", unsentNonces[i].nonce, err))
 		}
-		currentNonce = uint64(tmpNonce)
+	}
+	unsentNonces = nil
+}
+
+// Move on to the next nonce for the given address
+func nextNonce(address common.Address) {
+	if autoNonce {
+		// Reserve the next nonce when it is required
+		nonce = -1
 	} else {
-		currentNonce = uint64(nonce)
+		nonce++
 	}
-	return currentNonce, nil
 }
 
-// Obtain the next nonce for the given address
-func nextNonce(address common.Address) (nextNonce uint64, err error) {
-	if nonce == -1 {
-		_, err = currentNonce(address)
+// Obtain the node's view of the next nonce for the given address
+func pendingNonce(address common.Address) (uint64, error) {
+	if client == nil {
+		err := connect()
 		if err != nil {
-			return
+			return 0, err
 		}
 	}
-	nonce++
-	nextNonce = uint64(nonce)
-	return
+
+	ctx, cancel := localContext()
+	defer cancel()
+	res, err := client.PendingNonceAt(ctx, address)
+	if err != nil {
+		return 0, fmt.Errorf("failed to obtain nonce for %s: %v", address.Hex(), err)
+	}
+	return res, nil
+}
+
+// Obtain the nonce store for the given address
+func nonceStore(address common.Address) (*util.NonceStore, error) {
+	dir, err := dataDir()
+	if err != nil {
+		return nil, err
+	}
+	return util.NewNonceStore(dir, chainID, address)
+}
+
+// dataDir returns the directory in which Ethereal keeps its data
+func dataDir() (string, error) {
+	if viper.GetString("datadir") != "" {
+		return viper.GetString("datadir"), nil
+	}
+	home, err := homedir.Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".ethereal"), nil
 }
 
 // Estimate the gas required for a transaction
 func estimateGas(fromAddress common.Address, toAddress *common.Address, amount *big.Int, data []byte) (gas uint64, err error) {
-	msg := ethereum.CallMsg{From: fromAddress, To: toAddress, Value: amount, Data: data}
+	msg := ethereum.CallMsg{From: fromAddress, To: toAddress, Value: amount, Data: data, AccessList: accessList}
 	ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration("timeout"))
 	defer cancel()
 	gas, err = client.EstimateGas(ctx, msg)
@@ -413,6 +712,88 @@ func estimateGas(fromAddress common.Address, toAddress *common.Address, amount *
 	return
 }
 
+// Generate an access list for a transaction from the node
+func generateAccessList(fromAddress common.Address, toAddress *common.Address, amount *big.Int, data []byte) (types.AccessList, error) {
+	msg := ethereum.CallMsg{From: fromAddress, To: toAddress, Value: amount, Data: data}
+	ctx, cancel := localContext()
+	defer cancel()
+	list, gasUsed, vmErr, err := gethclient.New(rpcClient).CreateAccessList(ctx, msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate access list: %v", err)
+	}
+	if vmErr != "" {
+		outputIf(!quiet, fmt.Sprintf("Transaction is expected to fail: %s", vmErr))
+	}
+	outputIf(debug, fmt.Sprintf("Gas used with generated access list is %d", gasUsed))
+	if list == nil {
+		return types.AccessList{}, nil
+	}
+	return *list, nil
+}
+
+// setupFees decides between legacy and dynamic fee transactions, and fills in
+// any fee values that were not supplied by the user.
+// Dynamic fees are used unless a gas price is supplied or the chain does not
+// support them.
+func setupFees() error {
+	if feesConfigured {
+		return nil
+	}
+	if gasPrice != nil {
+		// Explicit gas price; legacy transaction
+		feesConfigured = true
+		return nil
+	}
+
+	if client == nil {
+		// Offline; dynamic fees can only be used if they are supplied
+		if maxFeePerGas == nil && maxPriorityFeePerGas == nil {
+			gasPrice = defaultGasPrice
+			feesConfigured = true
+			return nil
+		}
+		if maxFeePerGas == nil || maxPriorityFeePerGas == nil {
+			return errors.New("both --maxfeepergas and --maxpriorityfeepergas are required when offline")
+		}
+	} else {
+		ctx, cancel := localContext()
+		defer cancel()
+		header, err := client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("failed to obtain latest block header: %v", err)
+		}
+		if header.BaseFee == nil {
+			// Chain does not support dynamic fees
+			if maxFeePerGas != nil || maxPriorityFeePerGas != nil {
+				return errors.New("chain does not support dynamic fees; use --gasprice")
+			}
+			gasPrice = defaultGasPrice
+			feesConfigured = true
+			return nil
+		}
+		if maxPriorityFeePerGas == nil {
+			maxPriorityFeePerGas, err = client.SuggestGasTipCap(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to obtain suggested priority fee: %v", err)
+			}
+		}
+		if maxFeePerGas == nil {
+			// Allow for the base fee to double before the transaction is included
+			maxFeePerGas = new(big.Int).Add(new(big.Int).Mul(header.BaseFee, big.NewInt(2)), maxPriorityFeePerGas)
+		}
+		outputIf(debug, fmt.Sprintf("Base fee is %s", string2eth.WeiToString(header.BaseFee, true)))
+	}
+
+	if maxPriorityFeePerGas.Cmp(maxFeePerGas) > 0 {
+		return fmt.Errorf("max priority fee per gas %s is higher than max fee per gas %s", string2eth.WeiToString(maxPriorityFeePerGas, true), string2eth.WeiToString(maxFeePerGas, true))
+	}
+	outputIf(debug, fmt.Sprintf("Max fee per gas is %s", string2eth.WeiToString(maxFeePerGas, true)))
+	outputIf(debug, fmt.Sprintf("Max priority fee per gas is %s", string2eth.WeiToString(maxPriorityFeePerGas, true)))
+	dynamicFees = true
+	feesConfigured = true
+	return nil
+}
+
 // Create a transaction
 func createTransaction(fromAddress common.Address, toAddress *common.Address, amount *big.Int, gasLimit uint64, data []byte) (tx *types.Transaction, err error) {
 	// Obtain the nonce for the transaction
@@ -422,21 +803,64 @@ func createTransaction(fromAddress common.Address, toAddress *common.Address, am
 		return
 	}
 
+	// Access list for the transaction
+	if accessListAuto {
+		accessList, err = generateAccessList(fromAddress, toAddress, amount, data)
+		if err != nil {
+			return
+		}
+	}
+
 	// Gas limit for the transaction
 	if gasLimit == 0 {
+		if offline {
+			err = errors.New("--gaslimit is required when offline")
+			return
+		}
 		gasLimit, err = estimateGas(fromAddress, toAddress, amount, data)
 		if err != nil {
 			return
 		}
 	}
 
-	// TODO Gas price now that we know the gas limit?
+	err = setupFees()
+	if err != nil {
+		return
+	}
 
 	// Create the transaction
-	if toAddress == nil {
-		tx = types.NewContractCreation(txNonce, amount, gasLimit, gasPrice, data)
+	if dynamicFees {
+		tx = types.NewTx(&types.DynamicFeeTx{
+			ChainID:    chainID,
+			Nonce:      txNonce,
+			GasTipCap:  maxPriorityFeePerGas,
+			GasFeeCap:  maxFeePerGas,
+			Gas:        gasLimit,
+			To:         toAddress,
+			Value:      amount,
+			Data:       data,
+			AccessList: accessList,
+		})
+	} else if len(accessList) > 0 {
+		tx = types.NewTx(&types.AccessListTx{
+			ChainID:    chainID,
+			Nonce:      txNonce,
+			GasPrice:   gasPrice,
+			Gas:        gasLimit,
+			To:         toAddress,
+			Value:      amount,
+			Data:       data,
+			AccessList: accessList,
+		})
 	} else {
-		tx = types.NewTransaction(txNonce, *toAddress, amount, gasLimit, gasPrice, data)
+		tx = types.NewTx(&types.LegacyTx{
+			Nonce:    txNonce,
+			GasPrice: gasPrice,
+			Gas:      gasLimit,
+			To:       toAddress,
+			Value:    amount,
+			Data:     data,
+		})
 	}
 
 	return
@@ -449,6 +873,12 @@ func createSignedTransaction(fromAddress common.Address, toAddress *common.Addre
 	if err != nil {
 		return
 	}
+	if unsigned {
+		outputUnsignedTransaction(fromAddress, tx)
+	}
+	if simulate {
+		simulateTransaction(fromAddress, tx)
+	}
 
 	// Sign the transaction
 	signedTx, err = signTransaction(fromAddress, tx)
@@ -466,7 +896,31 @@ func createSignedTransaction(fromAddress common.Address, toAddress *common.Addre
 func generateTxOpts(sender common.Address) (opts *bind.TransactOpts, err error) {
 	// Signer depends on what information is available to us
 	var signer bind.SignerFn
-	if viper.GetString("passphrase") != "" {
+	if unsigned {
+		signer = func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			outputUnsignedTransaction(address, tx)
+			return tx, nil
+		}
+	} else if simulate {
+		signer = func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			simulateTransaction(address, tx)
+			return tx, nil
+		}
+	} else if viper.GetString("external-signer") != "" {
+		var extSigner *external.ExternalSigner
+		extSigner, err = obtainExternalSigner()
+		if err != nil {
+			return
+		}
+		signer = util.WalletSigner(chainID, extSigner, accounts.Account{Address: sender})
+	} else if viper.GetString("mnemonic") != "" {
+		var key *ecdsa.PrivateKey
+		key, err = mnemonicKey()
+		if err != nil {
+			return
+		}
+		signer = util.KeySigner(chainID, key)
+	} else if viper.GetString("passphrase") != "" {
 		var wallet accounts.Wallet
 		var account *accounts.Account
 		wallet, account, err = cli.ObtainWalletAndAccount(chainID, sender)
@@ -480,7 +934,7 @@ func generateTxOpts(sender common.Address) (opts *bind.TransactOpts, err error)
 		signer = util.KeySigner(chainID, key)
 	}
 	if signer == nil {
-		err = fmt.Errorf("no signer; please supply either passphrase or private key")
+		err = fmt.Errorf("no signer; please supply either passphrase, private key, mnemonic or external signer")
 		return
 	}
 
@@ -496,13 +950,46 @@ func generateTxOpts(sender common.Address) (opts *bind.TransactOpts, err error)
 	}
 	nonce = int64(curNonce)
 
+	err = setupFees()
+	if err != nil {
+		return
+	}
+
+	if offline {
+		// Bound contracts ask the node for the fees and gas of a transaction
+		// that they create, so cannot do so offline.  Instead they are given
+		// a placeholder gas price and the transaction itself is built from
+		// their destination, value and data in the same way as any other.
+		if gasLimit == 0 {
+			err = errors.New("--gaslimit is required when offline")
+			return
+		}
+		opts = &bind.TransactOpts{
+			From:     sender,
+			Value:    value,
+			NoSend:   true,
+			Nonce:    big.NewInt(0).SetInt64(nonce),
+			GasPrice: big.NewInt(0),
+			GasLimit: gasLimit,
+			Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+				return createSignedTransaction(address, tx.To(), tx.Value(), tx.Gas(), tx.Data())
+			},
+		}
+		return
+	}
+
 	opts = &bind.TransactOpts{
-		From:     sender,
-		Signer:   signer,
-		GasPrice: gasPrice,
-		Value:    value,
-		//DoNotSend: offline,
-		Nonce: big.NewInt(0).SetInt64(nonce),
+		From:   sender,
+		Signer: signer,
+		Value:  value,
+		NoSend: offline,
+		Nonce:  big.NewInt(0).SetInt64(nonce),
+	}
+	if dynamicFees {
+		opts.GasFeeCap = maxFeePerGas
+		opts.GasTipCap = maxPriorityFeePerGas
+	} else {
+		opts.GasPrice = gasPrice
 	}
 
 	if gasLimit != 0 {
@@ -512,7 +999,24 @@ func generateTxOpts(sender common.Address) (opts *bind.TransactOpts, err error)
 }
 
 func signTransaction(signer common.Address, tx *types.Transaction) (signedTx *types.Transaction, err error) {
-	if viper.GetString("passphrase") != "" {
+	if viper.GetString("external-signer") != "" {
+		var extSigner *external.ExternalSigner
+		extSigner, err = obtainExternalSigner()
+		if err != nil {
+			return
+		}
+		signedTx, err = util.WalletSigner(chainID, extSigner, accounts.Account{Address: signer})(signer, tx)
+	} else if viper.GetString("mnemonic") != "" {
+		var key *ecdsa.PrivateKey
+		key, err = mnemonicKey()
+		if err != nil {
+			return
+		}
+		if signer != crypto.PubkeyToAddress(key.PublicKey) {
+			return nil, errors.New("not authorized to sign this account")
+		}
+		signedTx, err = types.SignTx(tx, types.LatestSignerForChainID(chainID), key)
+	} else if viper.GetString("passphrase") != "" {
 		if wallet == nil {
 			// Fetch the wallet and account for the sender
 			wallet, account, err = cli.ObtainWalletAndAccount(chainID, signer)
@@ -529,57 +1033,175 @@ func signTransaction(signer common.Address, tx *types.Transaction) (signedTx *ty
 		if signer != keyAddr {
 			return nil, errors.New("not authorized to sign this account")
 		}
-		signedTx, err = types.SignTx(tx, types.NewEIP155Signer(chainID), key)
+		signedTx, err = types.SignTx(tx, types.LatestSignerForChainID(chainID), key)
 	} else {
-		err = errors.New("no passphrase or private key; cannot sign")
+		err = errors.New("no passphrase, private key, mnemonic or external signer; cannot sign")
 	}
 	return
 }
 
-func outputIf(condition bool, msg string) {
-	if condition {
-		fmt.Println(msg)
+// obtainExternalSigner obtains the external signer supplied by the user,
+// connecting to it on first use.
+func obtainExternalSigner() (*external.ExternalSigner, error) {
+	if externalSigner == nil {
+		var err error
+		externalSigner, err = cli.ObtainExternalSigner(viper.GetString("external-signer"))
+		if err != nil {
+			return nil, err
+		}
 	}
+	return externalSigner, nil
 }
 
-func localContext() (context.Context, context.CancelFunc) {
-	return context.WithTimeout(context.Background(), viper.GetDuration("timeout"))
+// mnemonicKey derives the private key from the mnemonic and path supplied by
+// the user.  If a passphrase is supplied it is used as the mnemonic's passphrase.
+func mnemonicKey() (*ecdsa.PrivateKey, error) {
+	key, err := util.MnemonicKey(viper.GetString("mnemonic"), viper.GetString("passphrase"), viper.GetString("hdpath"), true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key from mnemonic: %v", err)
+	}
+	return key, nil
 }
 
-func txFrom(tx *types.Transaction) (address common.Address, err error) {
-	V, _, _ := tx.RawSignatureValues()
-	signer := deriveSigner(V)
-	address, err = types.Sender(signer, tx)
-	return
+// outputUnsignedTransaction prints an unsigned transaction as JSON and exits
+func outputUnsignedTransaction(fromAddress common.Address, tx *types.Transaction) {
+	call := ""
+	if tx.To() != nil && len(tx.Data()) > 0 {
+		txdata.InitFunctionMap()
+		call = txdata.DataToString(client, tx.Data())
+		if strings.HasPrefix(call, "0x") {
+			// Not decoded
+			call = ""
+		}
+	}
+	data, err := json.MarshalIndent(util.NewUnsignedTransaction(fromAddress, chainID, tx, call), "", "  ")
+	cli.ErrCheck(err, quiet, "Failed to generate unsigned transaction")
+	if !quiet {
+		fmt.Println(string(data))
+	}
+	os.Exit(_exit_success)
 }
 
-// Stolen from geth code as this is not exposed
-func deriveChainID(v *big.Int) *big.Int {
-	if v.BitLen() <= 64 {
-		v := v.Uint64()
-		if v == 27 || v == 28 {
-			return new(big.Int)
+// simulateTransaction simulates a transaction, prints its effects and exits
+func simulateTransaction(fromAddress common.Address, tx *types.Transaction) {
+	txdata.InitFunctionMap()
+	ctx, cancel := localContext()
+	defer cancel()
+	diff, frame, err := util.SimulateTransaction(ctx, rpcClient, fromAddress, tx)
+	if err != nil {
+		// Node cannot trace calls; fall back to a plain call
+		outputIf(verbose, fmt.Sprintf("Failed to trace transaction (%v); state changes unavailable", err))
+		reverted, reason, err := callRevertReason(ethereum.CallMsg{
+			From:       fromAddress,
+			To:         tx.To(),
+			Gas:        tx.Gas(),
+			Value:      tx.Value(),
+			Data:       tx.Data(),
+			AccessList: tx.AccessList(),
+		}, nil, nil)
+		cli.ErrCheck(err, quiet, "Failed to simulate transaction")
+		if reverted {
+			outputIf(!quiet, fmt.Sprintf("Result:\t\tReverted: %s", reason))
+			os.Exit(_exit_failure)
+		}
+		outputIf(!quiet, "Result:\t\tSucceeded")
+		os.Exit(_exit_success)
+	}
+
+	if quiet {
+		if frame.Error != "" {
+			os.Exit(_exit_failure)
 		}
-		return new(big.Int).SetUint64((v - 35) / 2)
+		os.Exit(_exit_success)
 	}
-	v = new(big.Int).Sub(v, big.NewInt(35))
-	return v.Div(v, big.NewInt(2))
+
+	if frame.Error == "" {
+		fmt.Printf("Result:\t\tSucceeded\n")
+	} else {
+		reason := frame.RevertReason
+		if reason == "" && len(frame.Output) > 0 {
+			reason = txdata.RevertToString(client, nil, frame.Output)
+		}
+		if reason == "" {
+			fmt.Printf("Result:\t\tFailed: %s\n", frame.Error)
+		} else {
+			fmt.Printf("Result:\t\tFailed: %s: %s\n", frame.Error, reason)
+		}
+	}
+	fmt.Printf("Gas used:\t%d\n", uint64(frame.GasUsed))
+
+	balanceChanges := diff.BalanceChanges()
+	if len(balanceChanges) > 0 {
+		fmt.Printf("Balance changes:\n")
+		for _, change := range balanceChanges {
+			fmt.Printf("\t%v:\t%s → %s\n", ens.Format(client, change.Address), string2eth.WeiToString(change.Old, true), string2eth.WeiToString(change.New, true))
+		}
+	}
+
+	storageChanges := diff.StorageChanges()
+	if len(storageChanges) > 0 {
+		fmt.Printf("Storage changes:\n")
+		var lastAddress *common.Address
+		for _, change := range storageChanges {
+			if lastAddress == nil || *lastAddress != change.Address {
+				fmt.Printf("\t%v:\n", ens.Format(client, change.Address))
+				address := change.Address
+				lastAddress = &address
+			}
+			fmt.Printf("\t\t%s:\t%s → %s\n", change.Slot.Hex(), change.Old.Hex(), change.New.Hex())
+		}
+	}
+
+	logs := frame.AllLogs()
+	if len(logs) > 0 {
+		fmt.Printf("Events:\n")
+		for _, txLog := range logs {
+			decoded := ""
+			if len(txLog.Topics) > 0 {
+				decoded = txdata.EventToString(client, txLog)
+			}
+			if decoded == "" {
+				decoded = fmt.Sprintf("%v", txLog.Topics)
+			}
+			fmt.Printf("\t%v:\t%s\n", ens.Format(client, txLog.Address), decoded)
+		}
+	}
+
+	if frame.Error != "" {
+		os.Exit(_exit_failure)
+	}
+	os.Exit(_exit_success)
 }
 
-// Stolen from geth code as this is not exposed
-func isProtectedV(V *big.Int) bool {
-	if V.BitLen() <= 8 {
-		v := V.Uint64()
-		return v != 27 && v != 28
+func outputIf(condition bool, msg string) {
+	if condition {
+		fmt.Println(msg)
+	}
+}
+
+// structuredOutput returns true if the user has asked for structured output
+// with --output, in which case the command should supply its result to
+// outputResult() rather than generating its own text output.
+func structuredOutput() bool {
+	return outputFormat != output.FormatText
+}
+
+// outputResult outputs a result in the format requested by the user.
+func outputResult(result interface{}) {
+	if quiet {
+		return
 	}
-	// anything not 27 or 28 are considered unprotected
-	return true
+	err := output.Write(os.Stdout, outputFormat, result)
+	cli.ErrCheck(err, false, "Failed to generate output")
 }
 
-// Stolen from geth code as this is not exposed
-func deriveSigner(V *big.Int) types.Signer {
-	if V.Sign() != 0 && isProtectedV(V) {
-		return types.NewEIP155Signer(deriveChainID(V))
+func localContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), viper.GetDuration("timeout"))
+}
+
+func txFrom(tx *types.Transaction) (address common.Address, err error) {
+	if tx.Type() == types.LegacyTxType && !tx.Protected() {
+		return types.Sender(types.HomesteadSigner{}, tx)
 	}
-	return types.HomesteadSigner{}
+	return types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
 }
@@ -0,0 +1,186 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wealdtech/ethereal/util"
+	"github.com/wealdtech/ethereal/util/contracts"
+)
+
+// saveTransactionGlobals restores the globals used to create transactions
+// when the test finishes.
+func saveTransactionGlobals(t *testing.T) {
+	savedClient := client
+	savedOffline := offline
+	savedChainID := chainID
+	savedNonce := nonce
+	savedAutoNonce := autoNonce
+	savedGasPrice := gasPrice
+	savedGasLimit := gasLimit
+	savedMaxFeePerGas := maxFeePerGas
+	savedMaxPriorityFeePerGas := maxPriorityFeePerGas
+	savedDynamicFees := dynamicFees
+	savedFeesConfigured := feesConfigured
+	savedAccessList := accessList
+	savedPrivateKey := viper.GetString("privatekey")
+	t.Cleanup(func() {
+		client = savedClient
+		offline = savedOffline
+		chainID = savedChainID
+		nonce = savedNonce
+		autoNonce = savedAutoNonce
+		gasPrice = savedGasPrice
+		gasLimit = savedGasLimit
+		maxFeePerGas = savedMaxFeePerGas
+		maxPriorityFeePerGas = savedMaxPriorityFeePerGas
+		dynamicFees = savedDynamicFees
+		feesConfigured = savedFeesConfigured
+		accessList = savedAccessList
+		viper.Set("privatekey", savedPrivateKey)
+	})
+}
+
+func TestOfflineBoundTransaction(t *testing.T) {
+	saveTransactionGlobals(t)
+
+	sender := common.HexToAddress("0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf")
+	recipient := common.HexToAddress("0x2B5AD5c4795c026514f8317c7a215E218DcCD6cF")
+	tokenAddress := common.HexToAddress("0x6B175474E89094C44Da98b954EedeAC495271d0F")
+
+	tests := []struct {
+		gasPrice             *big.Int
+		maxFeePerGas         *big.Int
+		maxPriorityFeePerGas *big.Int
+		gasLimit             uint64
+		txType               uint8
+		err                  string
+	}{
+		{ // 0 - dynamic fees
+			maxFeePerGas:         big.NewInt(30000000000),
+			maxPriorityFeePerGas: big.NewInt(2000000000),
+			gasLimit:             60000,
+			txType:               types.DynamicFeeTxType,
+		},
+		{ // 1 - legacy
+			gasPrice: big.NewInt(20000000000),
+			gasLimit: 60000,
+			txType:   types.LegacyTxType,
+		},
+		{ // 2 - no gas limit
+			maxFeePerGas:         big.NewInt(30000000000),
+			maxPriorityFeePerGas: big.NewInt(2000000000),
+			err:                  "--gaslimit is required when offline",
+		},
+	}
+
+	for i, test := range tests {
+		client = nil
+		offline = true
+		chainID = big.NewInt(1)
+		nonce = 5
+		autoNonce = false
+		gasPrice = test.gasPrice
+		gasLimit = test.gasLimit
+		maxFeePerGas = test.maxFeePerGas
+		maxPriorityFeePerGas = test.maxPriorityFeePerGas
+		dynamicFees = false
+		feesConfigured = false
+		accessList = nil
+		viper.Set("privatekey", "0000000000000000000000000000000000000000000000000000000000000001")
+
+		token, err := contracts.NewERC20(tokenAddress, client)
+		require.Nil(t, err, "failed to create token contract")
+		opts, err := generateTxOpts(sender)
+		if test.err != "" {
+			require.NotNil(t, err, fmt.Sprintf("missing expected error at test %d", i))
+			assert.Equal(t, test.err, err.Error(), fmt.Sprintf("unexpected error value at test %d", i))
+			continue
+		}
+		require.Nil(t, err, fmt.Sprintf("failed to generate transaction options at test %d", i))
+
+		tx, err := token.Transfer(opts, recipient, big.NewInt(1000))
+		require.Nil(t, err, fmt.Sprintf("failed to create transaction at test %d", i))
+		assert.Equal(t, test.txType, tx.Type(), fmt.Sprintf("incorrect transaction type at test %d", i))
+		assert.Equal(t, tokenAddress, *tx.To(), fmt.Sprintf("incorrect destination at test %d", i))
+		assert.Equal(t, uint64(5), tx.Nonce(), fmt.Sprintf("incorrect nonce at test %d", i))
+		assert.Equal(t, test.gasLimit, tx.Gas(), fmt.Sprintf("incorrect gas limit at test %d", i))
+		if test.txType == types.DynamicFeeTxType {
+			assert.Equal(t, test.maxFeePerGas, tx.GasFeeCap(), fmt.Sprintf("incorrect max fee per gas at test %d", i))
+			assert.Equal(t, test.maxPriorityFeePerGas, tx.GasTipCap(), fmt.Sprintf("incorrect max priority fee per gas at test %d", i))
+		} else {
+			assert.Equal(t, test.gasPrice, tx.GasPrice(), fmt.Sprintf("incorrect gas price at test %d", i))
+		}
+		from, err := types.Sender(types.LatestSignerForChainID(chainID), tx)
+		require.Nil(t, err, fmt.Sprintf("failed to recover sender at test %d", i))
+		assert.Equal(t, sender, from, fmt.Sprintf("incorrect sender at test %d", i))
+	}
+}
+
+func TestReleaseUnsentNonces(t *testing.T) {
+	savedUnsentNonces := unsentNonces
+	t.Cleanup(func() { unsentNonces = savedUnsentNonces })
+
+	store, err := util.NewNonceStore(t.TempDir(), big.NewInt(1), common.HexToAddress("0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf"))
+	require.Nil(t, err)
+
+	tests := []struct {
+		count uint64
+		sent  []uint64
+		next  uint64
+	}{
+		{ // 0 - none sent
+			count: 1,
+			next:  10,
+		},
+		{ // 1 - all sent
+			count: 1,
+			sent:  []uint64{10},
+			next:  11,
+		},
+		{ // 2 - some sent
+			count: 3,
+			sent:  []uint64{10},
+			next:  11,
+		},
+		{ // 3 - later nonce sent leaves a gap
+			count: 3,
+			sent:  []uint64{11},
+			next:  12,
+		},
+	}
+
+	for i, test := range tests {
+		require.Nil(t, store.Reset(10), fmt.Sprintf("failed to reset store at test %d", i))
+		unsentNonces = nil
+		first, err := store.Reserve(10, test.count)
+		require.Nil(t, err, fmt.Sprintf("failed to reserve nonces at test %d", i))
+		trackReservedNonces(store, first, test.count)
+		for _, nonce := range test.sent {
+			nonceSent(nonce)
+		}
+		releaseUnsentNonces()
+		next, err := store.Peek(10)
+		require.Nil(t, err, fmt.Sprintf("failed to peek store at test %d", i))
+		assert.Equal(t, test.next, next, fmt.Sprintf("incorrect next nonce at test %d", i))
+	}
+}
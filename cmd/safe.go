@@ -0,0 +1,84 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+	"github.com/wealdtech/ethereal/cli"
+	"github.com/wealdtech/ethereal/util"
+	"github.com/wealdtech/ethereal/util/contracts"
+	ens "github.com/wealdtech/go-ens/v3"
+)
+
+var safeStr string
+var safeFile string
+
+// safeCmd represents the safe command
+var safeCmd = &cobra.Command{
+	Use:   "safe",
+	Short: "Manage Gnosis Safes",
+	Long:  `Obtain information about Gnosis Safes, and propose, sign and execute their transactions.`,
+}
+
+func init() {
+	RootCmd.AddCommand(safeCmd)
+}
+
+func safeFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&safeStr, "safe", "", "Address or ENS name of the Safe")
+}
+
+func safeFileFlag(cmd *cobra.Command, explanation string) {
+	cmd.Flags().StringVar(&safeFile, "file", "", explanation)
+}
+
+// safeContract obtains the address and contract of the Safe supplied by the user.
+func safeContract() (common.Address, *contracts.GnosisSafe) {
+	cli.Assert(safeStr != "", quiet, "--safe is required")
+	address, err := ens.Resolve(client, safeStr)
+	cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to resolve Safe address %s", safeStr))
+	safe, err := contracts.NewGnosisSafe(address, client)
+	cli.ErrCheck(err, quiet, "Failed to obtain Safe contract")
+	return address, safe
+}
+
+// readSafeTransaction reads a Safe transaction from a file.
+func readSafeTransaction(path string) *util.SafeTransaction {
+	data, err := ioutil.ReadFile(path)
+	cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to read %s", path))
+	tx := &util.SafeTransaction{}
+	err = json.Unmarshal(data, tx)
+	cli.ErrCheck(err, quiet, fmt.Sprintf("Invalid Safe transaction in %s", path))
+	return tx
+}
+
+// writeSafeTransaction writes a Safe transaction to a file, or to standard
+// output if no file is supplied.
+func writeSafeTransaction(path string, tx *util.SafeTransaction) {
+	data, err := json.MarshalIndent(tx, "", "  ")
+	cli.ErrCheck(err, quiet, "Failed to encode Safe transaction")
+	if path == "" {
+		if !quiet {
+			fmt.Println(string(data))
+		}
+		return
+	}
+	err = ioutil.WriteFile(path, append(data, '\n'), 0600)
+	cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to write %s", path))
+}
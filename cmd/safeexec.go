@@ -0,0 +1,142 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"os"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/wealdtech/ethereal/cli"
+	"github.com/wealdtech/ethereal/util/contracts"
+	"github.com/wealdtech/ethereal/util/txdata"
+	ens "github.com/wealdtech/go-ens/v3"
+)
+
+var safeExecFromAddress string
+
+// safeExecCmd represents the safe exec command
+var safeExecCmd = &cobra.Command{
+	Use:   "exec",
+	Short: "Execute a Safe transaction",
+	Long: `Execute a Safe transaction that has been signed by enough of the Safe's owners.  For example:
+
+    ethereal safe exec --file=tx.json --from=0x5FfC014343cd971B7eb70732021E26C35B744cc4 --passphrase=secret
+
+The transaction can be executed by any address.  If the address is an owner of the Safe that has not signed the transaction then executing it counts as its approval.  The Safe's nonce, owners and threshold are checked before the transaction is sent; these checks are not carried out if offline.
+
+This will return an exit status of 0 if the transaction is successfully submitted (and mined if --wait is supplied), 1 if the transaction is not successfully submitted, and 2 if the transaction is successfully submitted but not mined within the supplied time limit.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli.Assert(safeFile != "", quiet, "--file is required")
+		tx := readSafeTransaction(safeFile)
+		cli.Assert(tx.ChainID.Cmp(chainID) == 0, quiet, fmt.Sprintf("Safe transaction is for chain %v but the chain is %v", tx.ChainID, chainID))
+
+		cli.Assert(safeExecFromAddress != "", quiet, "--from is required")
+		fromAddress, err := ens.Resolve(client, safeExecFromAddress)
+		cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to resolve from address %s", safeExecFromAddress))
+
+		approvers := make([]common.Address, 0)
+		if !offline {
+			safe, err := contracts.NewGnosisSafe(tx.Safe, client)
+			cli.ErrCheck(err, quiet, "Failed to obtain Safe contract")
+			safeNonce, err := safe.Nonce(nil)
+			cli.ErrCheck(err, quiet, "Failed to obtain Safe nonce")
+			cli.Assert(safeNonce.Cmp(tx.Nonce) == 0, quiet, fmt.Sprintf("Safe transaction has nonce %v but the Safe's nonce is %v", tx.Nonce, safeNonce))
+			owners, err := safe.GetOwners(nil)
+			cli.ErrCheck(err, quiet, "Failed to obtain Safe owners")
+			threshold, err := safe.GetThreshold(nil)
+			cli.ErrCheck(err, quiet, "Failed to obtain Safe threshold")
+
+			signers := tx.Signers(owners)
+			cli.Assert(len(signers) == len(tx.Signatures), quiet, "Safe transaction has signatures from addresses that are not owners of the Safe")
+			signed := false
+			for _, signer := range signers {
+				if signer == fromAddress {
+					signed = true
+				}
+			}
+			if !signed {
+				for _, owner := range owners {
+					if owner == fromAddress {
+						approvers = append(approvers, fromAddress)
+						outputIf(verbose, "Sender is an owner of the Safe; sending the transaction approves it")
+					}
+				}
+			}
+			approvals := len(signers) + len(approvers)
+			cli.Assert(big.NewInt(int64(approvals)).Cmp(threshold) >= 0, quiet, fmt.Sprintf("Safe transaction has %d of %v required approvals", approvals, threshold))
+		}
+
+		safeAbi, err := contracts.GnosisSafeMetaData.GetAbi()
+		cli.ErrCheck(err, quiet, "Failed to parse Safe ABI")
+		data, err := safeAbi.Pack("execTransaction", tx.To, tx.Value, tx.Data, tx.Operation, tx.SafeTxGas, tx.BaseGas, tx.GasPrice, tx.GasToken, tx.RefundReceiver, tx.EncodedSignatures(approvers...))
+		cli.ErrCheck(err, quiet, "Failed to create Safe transaction")
+		outputIf(verbose, fmt.Sprintf("Data is %x", data))
+
+		// Ensure that the transaction will not revert
+		if !offline {
+			txdata.InitFunctionMap()
+			reverted, reason, err := callRevertReason(ethereum.CallMsg{
+				From: fromAddress,
+				To:   &tx.Safe,
+				Data: data,
+			}, nil, safeAbi)
+			cli.ErrCheck(err, quiet, "Failed to check Safe transaction")
+			if reverted {
+				if reason == "" {
+					reason = "no reason given"
+				}
+				cli.Err(quiet, fmt.Sprintf("Safe transaction would revert: %s", reason))
+			}
+		}
+
+		// Create and sign the transaction
+		signedTx, err := createSignedTransaction(fromAddress, &tx.Safe, big.NewInt(0), gasLimit, data)
+		cli.ErrCheck(err, quiet, "Failed to create Safe transaction")
+
+		if offline {
+			if !quiet {
+				data, err := signedTx.MarshalBinary()
+				cli.ErrCheck(err, quiet, "Failed to marshal transaction")
+				fmt.Printf("0x%s\n", hex.EncodeToString(data))
+			}
+			os.Exit(_exit_success)
+		}
+
+		ctx, cancel := localContext()
+		defer cancel()
+		err = client.SendTransaction(ctx, signedTx)
+		cli.ErrCheck(err, quiet, "Failed to send Safe transaction")
+
+		hash, _ := tx.Hash()
+		handleSubmittedTransaction(signedTx, log.Fields{
+			"group":      "safe",
+			"command":    "exec",
+			"safe":       tx.Safe.Hex(),
+			"safetxhash": hash.Hex(),
+		}, false)
+	},
+}
+
+func init() {
+	safeCmd.AddCommand(safeExecCmd)
+	safeFileFlag(safeExecCmd, "File containing the signed Safe transaction")
+	safeExecCmd.Flags().StringVar(&safeExecFromAddress, "from", "", "Address from which to execute the Safe transaction")
+	addTransactionFlags(safeExecCmd, "the address from which to execute the Safe transaction")
+}
@@ -0,0 +1,60 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wealdtech/ethereal/util"
+)
+
+// TestSafeExecOfflineNoGasLimit runs 'safe exec' in a separate process, as
+// the command exits on error.
+func TestSafeExecOfflineNoGasLimit(t *testing.T) {
+	if args := os.Getenv("ETHEREAL_TEST_ARGS"); args != "" {
+		os.Args = append([]string{"ethereal"}, strings.Split(args, " ")...)
+		Execute()
+		os.Exit(_exit_success)
+	}
+
+	dir := t.TempDir()
+	tx := util.NewSafeTransaction(common.HexToAddress("0x1c8b9b78e3085866521fe206fa4c1a67f49f153a"), big.NewInt(1), "1.3.0", big.NewInt(0), common.HexToAddress("0x5FfC014343cd971B7eb70732021E26C35B744cc4"), big.NewInt(1), nil, util.SafeOperationCall)
+	data, err := json.Marshal(tx)
+	require.Nil(t, err)
+	safeFile := filepath.Join(dir, "tx.json")
+	require.Nil(t, ioutil.WriteFile(safeFile, data, 0600))
+
+	cmd := exec.Command(os.Args[0], "-test.run=^TestSafeExecOfflineNoGasLimit$")
+	cmd.Env = append(os.Environ(),
+		"HOME="+dir,
+		"ETHEREAL_TEST_ARGS=safe exec --offline --file="+safeFile+" --from=0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf --privatekey=0x0000000000000000000000000000000000000000000000000000000000000001 --nonce=0 --gasprice=1gwei",
+	)
+	output, err := cmd.CombinedOutput()
+	var exitErr *exec.ExitError
+	require.True(t, errors.As(err, &exitErr), "command did not fail")
+	assert.Equal(t, _exit_failure, exitErr.ExitCode())
+	assert.NotContains(t, string(output), "panic")
+	assert.Contains(t, string(output), "Failed to create Safe transaction: --gaslimit is required when offline")
+}
@@ -0,0 +1,85 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wealdtech/ethereal/cli"
+	"github.com/wealdtech/ethereal/util"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+// safeHashCmd represents the safe hash command
+var safeHashCmd = &cobra.Command{
+	Use:   "hash",
+	Short: "Obtain the hash of a Safe transaction",
+	Long: `Obtain the EIP-712 hash of a Safe transaction, which is signed by its owners.  For example:
+
+    ethereal safe hash --file=tx.json
+
+With --verbose the details of the transaction and its signers are also shown, so that they can be checked before signing.
+
+In quiet mode this will return 0 if the transaction is valid, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli.Assert(safeFile != "", quiet, "--file is required")
+		tx := readSafeTransaction(safeFile)
+
+		typedData, err := tx.TypedData()
+		cli.ErrCheck(err, quiet, "Failed to obtain typed data")
+		hash, err := util.TypedDataHash(typedData)
+		cli.ErrCheck(err, quiet, "Failed to hash Safe transaction")
+
+		if quiet {
+			os.Exit(_exit_success)
+		}
+
+		if verbose {
+			fmt.Printf("Safe:\t\t%s\n", tx.Safe.Hex())
+			fmt.Printf("Chain ID:\t%v\n", tx.ChainID)
+			fmt.Printf("Nonce:\t\t%v\n", tx.Nonce)
+			fmt.Printf("To:\t\t%s\n", tx.To.Hex())
+			fmt.Printf("Value:\t\t%s\n", string2eth.WeiToString(tx.Value, true))
+			if tx.Operation == util.SafeOperationDelegateCall {
+				fmt.Println("Operation:\tdelegate call")
+			} else {
+				fmt.Println("Operation:\tcall")
+			}
+			fmt.Printf("Data:\t\t0x%x\n", tx.Data)
+			if calls, err := util.DecodeMultiSendData(tx.Data); err == nil && tx.Operation == util.SafeOperationDelegateCall {
+				fmt.Println("Batched calls:")
+				for _, call := range calls {
+					fmt.Printf("\t%s\t%s\t0x%x\n", call.To.Hex(), string2eth.WeiToString(call.Value, true), call.Data)
+				}
+			}
+			domainSeparator, err := util.TypedDataDomainSeparator(typedData)
+			cli.ErrCheck(err, quiet, "Failed to hash domain")
+			fmt.Printf("Domain separator:\t%#x\n", domainSeparator)
+			fmt.Println("Signers:")
+			for _, signature := range tx.Signatures {
+				fmt.Printf("\t%s\n", signature.Signer.Hex())
+			}
+		}
+
+		fmt.Printf("%#x\n", hash)
+	},
+}
+
+func init() {
+	offlineCmds["safe:hash"] = true
+	safeCmd.AddCommand(safeHashCmd)
+	safeFileFlag(safeHashCmd, "File containing the Safe transaction")
+}
@@ -0,0 +1,82 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wealdtech/ethereal/cli"
+	"github.com/wealdtech/ethereal/util/output"
+	ens "github.com/wealdtech/go-ens/v3"
+)
+
+// safeInfoCmd represents the safe info command
+var safeInfoCmd = &cobra.Command{
+	Use:   "info",
+	Short: "Obtain information about a Safe",
+	Long: `Obtain the owners, threshold and nonce of a Gnosis Safe.  For example:
+
+    ethereal safe info --safe=0x1c8b9b78e3085866521fe206fa4c1a67f49f153a
+
+In quiet mode this will return 0 if the address is a Safe, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		address, safe := safeContract()
+
+		version, err := safe.VERSION(nil)
+		cli.ErrCheck(err, quiet, "Failed to obtain Safe version; is this a Safe?")
+		owners, err := safe.GetOwners(nil)
+		cli.ErrCheck(err, quiet, "Failed to obtain Safe owners")
+		threshold, err := safe.GetThreshold(nil)
+		cli.ErrCheck(err, quiet, "Failed to obtain Safe threshold")
+		safeNonce, err := safe.Nonce(nil)
+		cli.ErrCheck(err, quiet, "Failed to obtain Safe nonce")
+
+		if quiet {
+			os.Exit(_exit_success)
+		}
+
+		if structuredOutput() {
+			result := &output.SafeInfo{
+				Address:   address.Hex(),
+				Version:   version,
+				Owners:    make([]string, len(owners)),
+				Threshold: threshold.Uint64(),
+				Nonce:     safeNonce.String(),
+			}
+			for i, owner := range owners {
+				result.Owners[i] = owner.Hex()
+			}
+			outputResult(result)
+			os.Exit(_exit_success)
+		}
+
+		if verbose {
+			fmt.Printf("Address:\t%s\n", ens.Format(client, address))
+			fmt.Printf("Version:\t%s\n", version)
+		}
+		fmt.Printf("Threshold:\t%v of %d\n", threshold, len(owners))
+		fmt.Printf("Nonce:\t\t%v\n", safeNonce)
+		fmt.Println("Owners:")
+		for _, owner := range owners {
+			fmt.Printf("\t%s\n", ens.Format(client, owner))
+		}
+	},
+}
+
+func init() {
+	safeCmd.AddCommand(safeInfoCmd)
+	safeFlags(safeInfoCmd)
+}
@@ -0,0 +1,53 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wealdtech/ethereal/cli"
+)
+
+// safeMergeCmd represents the safe merge command
+var safeMergeCmd = &cobra.Command{
+	Use:   "merge [file...]",
+	Short: "Merge signatures of a Safe transaction",
+	Long: `Merge the signatures from copies of a Safe transaction signed by different owners in to a single file.  For example:
+
+    ethereal safe merge --file=tx.json tx-alice.json tx-bob.json
+
+All of the files must contain the same transaction.
+
+In quiet mode this will return 0 if the signatures are merged, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli.Assert(safeFile != "", quiet, "--file is required")
+		cli.Assert(len(args) > 0, quiet, "at least one file to merge is required")
+		tx := readSafeTransaction(safeFile)
+
+		for _, path := range args {
+			err := tx.Merge(readSafeTransaction(path))
+			cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to merge %s", path))
+		}
+		outputIf(verbose, fmt.Sprintf("Transaction has %d signature(s)", len(tx.Signatures)))
+
+		writeSafeTransaction(safeFile, tx)
+	},
+}
+
+func init() {
+	offlineCmds["safe:merge"] = true
+	safeCmd.AddCommand(safeMergeCmd)
+	safeFileFlag(safeMergeCmd, "File containing the Safe transaction, to which the signatures are added")
+}
@@ -0,0 +1,268 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+	"github.com/wealdtech/ethereal/cli"
+	"github.com/wealdtech/ethereal/util"
+	"github.com/wealdtech/ethereal/util/contracts"
+	"github.com/wealdtech/ethereal/util/funcparser"
+	"github.com/wealdtech/ethereal/util/txdata"
+	ens "github.com/wealdtech/go-ens/v3"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+var safeProposeTo string
+var safeProposeAmount string
+var safeProposeData string
+var safeProposeCall string
+var safeProposeDelegateCall bool
+var safeProposeMultiSend string
+var safeProposeNonce int64
+var safeProposeVersion string
+
+// safeProposeCmd represents the safe propose command
+var safeProposeCmd = &cobra.Command{
+	Use:   "propose",
+	Short: "Propose a Safe transaction",
+	Long: `Create a transaction for a Gnosis Safe, ready to be signed by its owners.  For example:
+
+    ethereal safe propose --safe=0x1c8b9b78e3085866521fe206fa4c1a67f49f153a --to=0x52f1A3027d3aA514F17E454C93ae1F79b3B12d5d --amount=1.5ether --file=tx.json
+
+The transaction is built from the same inputs as the commands that send transactions directly:
+
+  - Ether is sent with --to, --amount and optionally --data, as with 'transaction send'
+  - contract methods are sent with --contract, --abi or --function, and --call, as with 'contract send'
+  - tokens are transferred with --token, --to and --amount, as with 'token transfer'
+
+Multiple transfers of Ether or tokens can be carried out in a single Safe transaction by supplying a CSV file with one transfer per line with --batch, as with 'ether transfer' and 'token transfer'.  The transfers are batched with the MultiSendCallOnly contract at --multisend.
+
+The transaction is written to --file, or printed if --file is not supplied.  The Safe's version and nonce are obtained from the chain unless supplied with --safeversion and --safenonce, which are required if offline.
+
+In quiet mode this will return 0 if the transaction is created, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		safeAddress, safe := safeContract()
+
+		var to common.Address
+		value := big.NewInt(0)
+		var data []byte
+		var contractAbi *abi.ABI
+		var err error
+		operation := util.SafeOperationCall
+		switch {
+		case batchFile != "":
+			cli.Assert(safeProposeTo == "" && safeProposeAmount == "" && safeProposeData == "" && contractStr == "", quiet, "--to, --amount, --data and --contract cannot be supplied with --batch")
+			cli.Assert(!safeProposeDelegateCall, quiet, "--delegatecall cannot be supplied with --batch")
+			cli.Assert(!offline, quiet, "Offline mode not supported with --batch")
+			data, err = util.MultiSendData(safeBatchCalls(safeAddress))
+			cli.ErrCheck(err, quiet, "Failed to create batch")
+			to, err = ens.Resolve(client, safeProposeMultiSend)
+			cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to resolve MultiSend address %s", safeProposeMultiSend))
+			// MultiSend carries out the calls in the context of the Safe.
+			operation = util.SafeOperationDelegateCall
+		case contractStr != "":
+			cli.Assert(safeProposeTo == "" && safeProposeData == "", quiet, "--to and --data cannot be supplied with --contract")
+			cli.Assert(safeProposeCall != "", quiet, "--call is required")
+			contract := parseContract("")
+			method, methodArgs, err := funcparser.ParseCall(client, contract, safeProposeCall)
+			cli.ErrCheck(err, quiet, "Failed to parse call")
+			data, err = contract.Abi.Pack(method.Name, methodArgs...)
+			cli.ErrCheck(err, quiet, "Failed to convert arguments")
+			contractAbi = &contract.Abi
+			to, err = ens.Resolve(client, contractStr)
+			cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to resolve contract address %s", contractStr))
+			if safeProposeAmount != "" {
+				value, err = string2eth.StringToWei(safeProposeAmount)
+				cli.ErrCheck(err, quiet, fmt.Sprintf("Invalid amount %s", safeProposeAmount))
+			}
+		case tokenStr != "":
+			cli.Assert(safeProposeData == "", quiet, "--data cannot be supplied with --token")
+			cli.Assert(!offline, quiet, "Offline mode not supported with --token")
+			cli.Assert(safeProposeTo != "", quiet, "--to is required")
+			recipient, err := ens.Resolve(client, safeProposeTo)
+			cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to resolve to address %s", safeProposeTo))
+			token, err := tokenContract(tokenStr)
+			cli.ErrCheck(err, quiet, "Failed to obtain token contract")
+			decimals, err := token.Decimals(nil)
+			cli.ErrCheck(err, quiet, "Failed to obtain token decimals")
+			cli.Assert(safeProposeAmount != "", quiet, "--amount is required")
+			amount, err := util.StringToTokenValue(safeProposeAmount, decimals)
+			cli.ErrCheck(err, quiet, "Invalid amount")
+			balance, err := token.BalanceOf(nil, safeAddress)
+			cli.ErrCheck(err, quiet, "Failed to obtain token balance of Safe")
+			cli.Assert(balance.Cmp(amount) >= 0, quiet, fmt.Sprintf("Balance of %s insufficient for transfer", util.TokenValueToString(balance, decimals, false)))
+			tokenAbi, err := abi.JSON(strings.NewReader(contracts.ERC20ABI))
+			cli.ErrCheck(err, quiet, "Failed to parse token ABI")
+			data, err = tokenAbi.Pack("transfer", recipient, amount)
+			cli.ErrCheck(err, quiet, "Failed to create token transfer")
+			contractAbi = &tokenAbi
+			to, err = tokenContractAddress(tokenStr)
+			cli.ErrCheck(err, quiet, "Failed to obtain token address")
+		default:
+			cli.Assert(safeProposeTo != "", quiet, "--to, --contract, --token or --batch is required")
+			to, err = ens.Resolve(client, safeProposeTo)
+			cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to resolve to address %s", safeProposeTo))
+			if safeProposeAmount != "" {
+				value, err = string2eth.StringToWei(safeProposeAmount)
+				cli.ErrCheck(err, quiet, fmt.Sprintf("Invalid amount %s", safeProposeAmount))
+			}
+			safeProposeData = strings.TrimPrefix(safeProposeData, "0x")
+			if len(safeProposeData)%2 == 1 {
+				safeProposeData = "0" + safeProposeData
+			}
+			data, err = hex.DecodeString(safeProposeData)
+			cli.ErrCheck(err, quiet, "Failed to parse data")
+		}
+		if safeProposeDelegateCall {
+			operation = util.SafeOperationDelegateCall
+		}
+		outputIf(verbose, fmt.Sprintf("Data is %x", data))
+
+		version := safeProposeVersion
+		if version == "" {
+			cli.Assert(!offline, quiet, "--safeversion is required if offline")
+			version, err = safe.VERSION(nil)
+			cli.ErrCheck(err, quiet, "Failed to obtain Safe version; is this a Safe?")
+		}
+		cli.Assert(safeProposeNonce >= -1, quiet, "--safenonce cannot be negative")
+		var safeNonce *big.Int
+		if safeProposeNonce == -1 {
+			cli.Assert(!offline, quiet, "--safenonce is required if offline")
+			safeNonce, err = safe.Nonce(nil)
+			cli.ErrCheck(err, quiet, "Failed to obtain Safe nonce")
+		} else {
+			safeNonce = big.NewInt(safeProposeNonce)
+		}
+
+		tx := util.NewSafeTransaction(safeAddress, chainID, version, safeNonce, to, value, data, operation)
+		hash, err := tx.Hash()
+		cli.ErrCheck(err, quiet, "Failed to hash Safe transaction")
+
+		if !offline {
+			// Ensure that the Safe agrees on the hash to be signed.
+			safeHash, err := safe.GetTransactionHash(nil, tx.To, tx.Value, tx.Data, tx.Operation, tx.SafeTxGas, tx.BaseGas, tx.GasPrice, tx.GasToken, tx.RefundReceiver, tx.Nonce)
+			cli.ErrCheck(err, quiet, "Failed to obtain Safe transaction hash")
+			cli.Assert(safeHash == hash, quiet, fmt.Sprintf("Safe transaction hash %#x does not match that of the Safe %#x", hash, safeHash))
+
+			// Ensure that the call will not revert.  Delegate calls run in
+			// the context of the Safe so cannot be checked this way.
+			if operation == util.SafeOperationCall {
+				txdata.InitFunctionMap()
+				reverted, reason, err := callRevertReason(ethereum.CallMsg{
+					From:  safeAddress,
+					To:    &to,
+					Value: value,
+					Data:  data,
+				}, nil, contractAbi)
+				cli.ErrCheck(err, quiet, "Failed to check Safe transaction")
+				if reverted {
+					if reason == "" {
+						reason = "no reason given"
+					}
+					cli.Err(quiet, fmt.Sprintf("Safe transaction would revert: %s", reason))
+				}
+			}
+		}
+		outputIf(verbose, fmt.Sprintf("Safe transaction hash is %#x", hash))
+
+		writeSafeTransaction(safeFile, tx)
+	},
+}
+
+// safeBatchCalls validates the transfers in the batch file and returns the
+// calls that carry them out from the Safe.
+func safeBatchCalls(safeAddress common.Address) []*util.SafeCall {
+	var spec *batchSpec
+	var err error
+	if tokenStr != "" {
+		spec, err = tokenBatchSpec(safeAddress)
+		cli.ErrCheck(err, quiet, "Failed to obtain token contract")
+	} else {
+		spec = etherBatchSpec()
+		ctx, cancel := localContext()
+		defer cancel()
+		spec.balance, err = client.BalanceAt(ctx, safeAddress, nil)
+		cli.ErrCheck(err, quiet, "Failed to obtain balance of Safe")
+	}
+
+	transfers, err := util.ParseBatchTransfers(batchFile)
+	cli.ErrCheck(err, quiet, "Failed to parse batch file")
+	cli.Assert(len(transfers) > 0, quiet, "Batch file contains no transfers")
+
+	// Validate every transfer before creating the batch
+	calls := make([]*util.SafeCall, 0, len(transfers))
+	invalid := 0
+	total := big.NewInt(0)
+	for _, transfer := range transfers {
+		call, amount, err := safeBatchCall(transfer, spec)
+		if err != nil {
+			outputIf(!quiet, fmt.Sprintf("Line %d: %v", transfer.Line, err))
+			invalid++
+			continue
+		}
+		total.Add(total, amount)
+		calls = append(calls, call)
+	}
+	cli.Assert(invalid == 0, quiet, fmt.Sprintf("Batch file contains %d invalid transfer(s)", invalid))
+	cli.Assert(spec.balance.Cmp(total) >= 0, quiet, fmt.Sprintf("Balance of %s insufficient for transfers of %s", spec.formatAmount(spec.balance), spec.formatAmount(total)))
+	outputIf(verbose, fmt.Sprintf("Batching %d transfer(s) totalling %s", len(calls), spec.formatAmount(total)))
+	return calls
+}
+
+// safeBatchCall validates a transfer and returns the call that carries it out
+// along with its amount.
+func safeBatchCall(transfer *util.BatchTransfer, spec *batchSpec) (*util.SafeCall, *big.Int, error) {
+	to, err := ens.Resolve(client, transfer.To)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to resolve %s: %v", transfer.To, err)
+	}
+	amount, err := spec.parseAmount(transfer.Amount)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid amount %s: %v", transfer.Amount, err)
+	}
+	if amount.Sign() < 0 {
+		return nil, nil, fmt.Errorf("negative amount %s", transfer.Amount)
+	}
+	txTo, txValue, txData, err := spec.txParams(to, amount, transfer.Data)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &util.SafeCall{To: *txTo, Value: txValue, Data: txData}, amount, nil
+}
+
+func init() {
+	safeCmd.AddCommand(safeProposeCmd)
+	safeFlags(safeProposeCmd)
+	contractFlags(safeProposeCmd)
+	tokenFlags(safeProposeCmd)
+	safeFileFlag(safeProposeCmd, "File to which to write the Safe transaction (default standard output)")
+	safeProposeCmd.Flags().StringVar(&safeProposeTo, "to", "", "Address to which to send Ether or tokens")
+	safeProposeCmd.Flags().StringVar(&safeProposeAmount, "amount", "", "Amount of Ether or tokens to send")
+	safeProposeCmd.Flags().StringVar(&safeProposeData, "data", "", "data to send with transaction (as a hex string)")
+	safeProposeCmd.Flags().StringVar(&safeProposeCall, "call", "", "Contract function to call")
+	safeProposeCmd.Flags().BoolVar(&safeProposeDelegateCall, "delegatecall", false, "Delegate call rather than call, running the code of the target in the context of the Safe")
+	safeProposeCmd.Flags().StringVar(&batchFile, "batch", "", "CSV file of transfers (to,amount[,data]) to carry out in a single Safe transaction")
+	safeProposeCmd.Flags().StringVar(&safeProposeMultiSend, "multisend", util.MultiSendCallOnlyAddress.Hex(), "Address of the MultiSendCallOnly contract used to carry out --batch")
+	safeProposeCmd.Flags().Int64Var(&safeProposeNonce, "safenonce", -1, "Nonce of the Safe transaction; -1 is the Safe's current nonce")
+	safeProposeCmd.Flags().StringVar(&safeProposeVersion, "safeversion", "", "Version of the Safe (required if offline)")
+}
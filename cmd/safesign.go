@@ -0,0 +1,132 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/wealdtech/ethereal/cli"
+	"github.com/wealdtech/ethereal/util"
+)
+
+var safeSignOwner string
+
+// safeSignCmd represents the safe sign command
+var safeSignCmd = &cobra.Command{
+	Use:   "sign",
+	Short: "Sign a Safe transaction",
+	Long: `Sign a Safe transaction as one of the Safe's owners, adding the signature to the file containing the transaction.  For example:
+
+    ethereal safe sign --file=tx.json --owner=0x5FfC014343cd971B7eb70732021E26C35B744cc4 --passphrase=secret
+
+The owner is not required when signing with --privatekey or --mnemonic.  To sign with an external signer such as Clef supply its URL or IPC path with --external-signer; the signer is shown the full transaction as EIP-712 typed data.
+
+Signing does not require access to the chain, so owners can sign copies of the same file independently and combine them with 'safe merge'.
+
+In quiet mode this will return 0 if the transaction is signed, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli.Assert(safeFile != "", quiet, "--file is required")
+		tx := readSafeTransaction(safeFile)
+
+		var owner *common.Address
+		if safeSignOwner != "" {
+			cli.Assert(common.IsHexAddress(safeSignOwner), quiet, fmt.Sprintf("Invalid owner address %s", safeSignOwner))
+			address := common.HexToAddress(safeSignOwner)
+			owner = &address
+		}
+
+		signer, signature, err := signSafeTransaction(owner, tx)
+		cli.ErrCheck(err, quiet, "Failed to sign Safe transaction")
+		err = tx.AddSignature(signer, signature)
+		cli.ErrCheck(err, quiet, "Invalid signature")
+		outputIf(verbose, fmt.Sprintf("Signed by %s", signer.Hex()))
+
+		writeSafeTransaction(safeFile, tx)
+	},
+}
+
+// signSafeTransaction signs a Safe transaction as the given owner, returning
+// the owner and signature.  The owner is obtained from the key if not supplied.
+func signSafeTransaction(owner *common.Address, tx *util.SafeTransaction) (common.Address, []byte, error) {
+	if viper.GetString("external-signer") != "" {
+		if owner == nil {
+			return common.Address{}, nil, errors.New("owner is required to sign with an external signer")
+		}
+		typedData, err := tx.TypedData()
+		if err != nil {
+			return common.Address{}, nil, err
+		}
+		input, err := json.Marshal(typedData)
+		if err != nil {
+			return common.Address{}, nil, err
+		}
+		client, err := rpc.Dial(viper.GetString("external-signer"))
+		if err != nil {
+			return common.Address{}, nil, fmt.Errorf("failed to access external signer: %v", err)
+		}
+		ctx, cancel := localContext()
+		defer cancel()
+		signature, err := util.ExternalSignTypedData(ctx, client, *owner, input)
+		return *owner, signature, err
+	}
+
+	var key *ecdsa.PrivateKey
+	var err error
+	switch {
+	case viper.GetString("mnemonic") != "":
+		key, err = mnemonicKey()
+	case viper.GetString("passphrase") != "":
+		if owner == nil {
+			return common.Address{}, nil, errors.New("owner is required to sign with a passphrase")
+		}
+		key, err = util.PrivateKeyForAccount(tx.ChainID, *owner, viper.GetString("passphrase"))
+	case viper.GetString("privatekey") != "":
+		key, err = crypto.HexToECDSA(strings.TrimPrefix(viper.GetString("privatekey"), "0x"))
+	default:
+		err = errors.New("no passphrase, private key, mnemonic or external signer; cannot sign")
+	}
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	keyAddress := crypto.PubkeyToAddress(key.PublicKey)
+	if owner != nil && *owner != keyAddress {
+		return common.Address{}, nil, errors.New("not authorized to sign for this owner")
+	}
+
+	hash, err := tx.Hash()
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	signature, err := crypto.Sign(hash.Bytes(), key)
+	return keyAddress, signature, err
+}
+
+func init() {
+	offlineCmds["safe:sign"] = true
+	safeCmd.AddCommand(safeSignCmd)
+	safeFileFlag(safeSignCmd, "File containing the Safe transaction, to which the signature is added")
+	safeSignCmd.Flags().StringVar(&safeSignOwner, "owner", "", "Address of the owner signing the transaction")
+	safeSignCmd.Flags().String("passphrase", "", "passphrase for the owner")
+	safeSignCmd.Flags().String("privatekey", "", "private key for the owner")
+	addMnemonicFlags(safeSignCmd, "the owner")
+}
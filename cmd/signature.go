@@ -17,12 +17,15 @@ import (
 	"encoding/csv"
 	"encoding/hex"
 	"fmt"
+	"io/ioutil"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/spf13/cobra"
 	"github.com/wealdtech/ethereal/cli"
+	"github.com/wealdtech/ethereal/util"
 	"github.com/wealdtech/ethereal/util/funcparser"
 )
 
@@ -30,6 +33,7 @@ var signatureDataStr string
 var signatureTypes string
 var signatureNoHash bool
 var signaturePacked bool
+var signatureTypedData string
 
 // signatureCmd represents the signature command
 var signatureCmd = &cobra.Command{
@@ -39,7 +43,9 @@ var signatureCmd = &cobra.Command{
 	Long:    `Sign and verify information.`,
 }
 
-func generateDataHash() []byte {
+// generateData generates the data to sign, before the standard Ethereum
+// signing message is prepended.
+func generateData() []byte {
 	var data []byte
 	if signatureTypes == "" {
 		// No types; might be a hex string or a non-hex string
@@ -94,6 +100,43 @@ func generateDataHash() []byte {
 		data = crypto.Keccak256(data)
 		outputIf(verbose, fmt.Sprintf("Hashed data is %x", data))
 	}
+	return data
+}
+
+// generateTypedData reads and parses the EIP-712 typed data document,
+// returning its contents and the parsed data.
+func generateTypedData() ([]byte, *apitypes.TypedData) {
+	input, err := ioutil.ReadFile(signatureTypedData)
+	cli.ErrCheck(err, quiet, "Failed to read typed data")
+	typedData, err := util.ParseTypedData(input)
+	cli.ErrCheck(err, quiet, "Failed to parse typed data")
+	return input, typedData
+}
+
+// generateTypedDataHash generates the EIP-712 hash of the typed data.
+func generateTypedDataHash() []byte {
+	_, typedData := generateTypedData()
+	if verbose {
+		domainSeparator, err := util.TypedDataDomainSeparator(typedData)
+		cli.ErrCheck(err, quiet, "Failed to hash domain")
+		outputIf(verbose, fmt.Sprintf("Domain separator is %x", domainSeparator))
+		messageHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+		cli.ErrCheck(err, quiet, "Failed to hash message")
+		outputIf(verbose, fmt.Sprintf("Message hash is %x", messageHash))
+	}
+	hash, err := util.TypedDataHash(typedData)
+	cli.ErrCheck(err, quiet, "Failed to hash typed data")
+	outputIf(verbose, fmt.Sprintf("Hash to sign is %x", hash))
+	return hash.Bytes()
+}
+
+// generateDataHash generates the hash to sign, either of typed data or of
+// data with the standard Ethereum signing message prepended.
+func generateDataHash() []byte {
+	if signatureTypedData != "" {
+		return generateTypedDataHash()
+	}
+	data := generateData()
 	buffer := make([]byte, 0)
 	buffer = append(buffer, []byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d", len(data)))...)
 	buffer = append(buffer, data...)
@@ -117,7 +160,7 @@ func argumentsAndValues(items string, types string) (abi.Arguments, []interface{
 	vals := make([]interface{}, 0)
 	arguments := abi.Arguments{}
 	for i := range dataTypes {
-		dataType, err := abi.NewType(dataTypes[i], nil)
+		dataType, err := abi.NewType(dataTypes[i], "", nil)
 		cli.ErrCheck(err, quiet, fmt.Sprintf("Unknown data type %s", dataTypes[i]))
 		argument := abi.Argument{
 			Type: dataType,
@@ -140,4 +183,5 @@ func signatureFlags(cmd *cobra.Command) {
 	cmd.Flags().StringVar(&signatureTypes, "types", "", "Comma-separated list of data types")
 	cmd.Flags().BoolVar(&signatureNoHash, "nohash", false, "do not hash the message prior to signing")
 	cmd.Flags().BoolVar(&signaturePacked, "packed", false, "use Solidity packed encoding")
+	cmd.Flags().StringVar(&signatureTypedData, "typed-data", "", "file containing EIP-712 typed data, in place of --data")
 }
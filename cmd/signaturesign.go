@@ -19,9 +19,12 @@ import (
 	"os"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 	"github.com/wealdtech/ethereal/cli"
 	"github.com/wealdtech/ethereal/util"
 )
@@ -58,25 +61,80 @@ provided below:
 	number of bytes in the data and finally the data itself, for example
     "\\x19Ethereum Signed Message:\n11Hello world"
   - the message is signed with the provided account or private key
+
+To sign EIP-712 typed structured data supply a file containing the standard
+JSON document of types, primaryType, domain and message with --typed-data in
+place of --data, for example:
+
+    ethereal signature sign --typed-data=permit.json --signer=0x1234...5678 --passphrase=secret
+
+The typed data is hashed as defined by EIP-712, and the hash is signed without
+the standard Ethereum signing message.
+
+To sign with a key derived from a BIP-39 mnemonic supply it with --mnemonic,
+and the derivation path with --path if it is not the default; --passphrase,
+if supplied, is the mnemonic's passphrase.  For example:
+
+    ethereal signature sign --data="Hello world" --mnemonic="test test test test test test test test test test test junk"
+
+To sign with an external signer such as Clef supply its URL or IPC path with
+--external-signer, for example:
+
+    ethereal signature sign --data="Hello world" --signer=0x1234...5678 --external-signer=http://localhost:8550/
 `,
 	Run: func(cmd *cobra.Command, args []string) {
-		cli.Assert(signatureDataStr != "", quiet, "--data is required")
+		cli.Assert(signatureDataStr != "" || signatureTypedData != "", quiet, "--data or --typed-data is required")
+		cli.Assert(signatureDataStr == "" || signatureTypedData == "", quiet, "only one of --data and --typed-data can be supplied")
+
+		signerAddress := signatureSignSigner
+		endpoint := viper.GetString("external-signer")
+
+		var signature []byte
+		var err error
+		if endpoint != "" {
+			cli.Assert(common.IsHexAddress(signerAddress), quiet, "--signer is required to sign with an external signer")
+			if signatureTypedData != "" {
+				// The external signer hashes the typed data itself.
+				input, _ := generateTypedData()
+				client, err := rpc.Dial(endpoint)
+				cli.ErrCheck(err, quiet, "Failed to access external signer")
+				ctx, cancel := localContext()
+				defer cancel()
+				signature, err = util.ExternalSignTypedData(ctx, client, common.HexToAddress(signerAddress), input)
+				cli.ErrCheck(err, quiet, "Failed to sign typed data")
+			} else {
+				extSigner, err := cli.ObtainExternalSigner(endpoint)
+				cli.ErrCheck(err, quiet, "Failed to access external signer")
+				// The external signer adds the standard Ethereum signing message
+				// and hashes the data itself.
+				signature, err = extSigner.SignText(accounts.Account{Address: common.HexToAddress(signerAddress)}, generateData())
+				cli.ErrCheck(err, quiet, "Failed to sign data")
+			}
+			if !quiet {
+				fmt.Printf("%x\n", signature)
+			}
+			os.Exit(_exit_success)
+		}
 
 		dataHash := generateDataHash()
 
 		// Sign the hash
-		var signature []byte
 		var key *ecdsa.PrivateKey
-		var err error
-		if signatureSignPassphrase != "" {
-			signer := common.HexToAddress(signatureSignSigner)
+		if viper.GetString("mnemonic") != "" {
+			key, err = mnemonicKey()
+			cli.ErrCheck(err, quiet, "Invalid mnemonic")
+			if signerAddress != "" {
+				cli.Assert(common.IsHexAddress(signerAddress) && common.HexToAddress(signerAddress) == crypto.PubkeyToAddress(key.PublicKey), quiet, "Key derived from mnemonic is not for the signer")
+			}
+		} else if signatureSignPassphrase != "" {
+			signer := common.HexToAddress(signerAddress)
 			key, err = util.PrivateKeyForAccount(chainID, signer, signatureSignPassphrase)
 			cli.ErrCheck(err, quiet, "Invalid account or passphrse")
 		} else if signatureSignPrivateKey != "" {
 			key, err = crypto.HexToECDSA(strings.TrimPrefix(signatureSignPrivateKey, "0x"))
 			cli.ErrCheck(err, quiet, "Invalid private key")
 		} else {
-			cli.Err(quiet, fmt.Sprintf("no passphrase or private key; cannot sign"))
+			cli.Err(quiet, "no passphrase, private key, mnemonic or external signer; cannot sign")
 		}
 		signature, err = crypto.Sign(dataHash, key)
 		cli.ErrCheck(err, quiet, "Failed to sign data")
@@ -96,4 +154,5 @@ func init() {
 	signatureSignCmd.Flags().StringVar(&signatureSignSigner, "signer", "", "Address of the account to sign the data")
 	signatureSignCmd.Flags().StringVar(&signatureSignPassphrase, "passphrase", "", "Passphrase of the account to sign the data")
 	signatureSignCmd.Flags().StringVar(&signatureSignPrivateKey, "privatekey", "", "Private key to sign the data")
+	addMnemonicFlags(signatureSignCmd, "the account to sign the data")
 }
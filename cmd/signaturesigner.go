@@ -22,7 +22,6 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/spf13/cobra"
 	"github.com/wealdtech/ethereal/cli"
-	ens "github.com/wealdtech/go-ens/v2"
 )
 
 var signatureSignerSignature string
@@ -35,9 +34,12 @@ var signatureSignerCmd = &cobra.Command{
 
     ethereal signature signer --data="false,2,0x5FfC014343cd971B7eb70732021E26C35B744cc4" --types="bool,uint256,address" --signature=0xcefd09e935b867a231086f41d98644655081a6e4e87c43e05fbbf621dfda69ea305c64fcf73907e09ce242c8ab8bcb953c4b45dd78262d8e34b22a8e4309734f00
 
+    ethereal signature signer --typed-data=mail.json --signature=0x4355c47d63924e8a72e509b65029052eb6c299d53a04e167c5775fd466751c9d07299936d304c153f6443dfa05f40ff007d72911b6f72307f996231605b9156201
+
 In quiet mode this will return 0 if the signature provides a valid signer, otherwise 1.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		cli.Assert(signatureDataStr != "", quiet, "--data is required")
+		cli.Assert(signatureDataStr != "" || signatureTypedData != "", quiet, "--data or --typed-data is required")
+		cli.Assert(signatureDataStr == "" || signatureTypedData == "", quiet, "only one of --data and --typed-data can be supplied")
 
 		dataHash := generateDataHash()
 
@@ -53,7 +55,7 @@ In quiet mode this will return 0 if the signature provides a valid signer, other
 			os.Exit(_exit_success)
 		}
 
-		fmt.Printf("%s\n", ens.Format(client, address))
+		fmt.Printf("%s\n", address.Hex())
 	},
 }
 
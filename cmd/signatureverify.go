@@ -14,15 +14,17 @@
 package cmd
 
 import (
-	"bytes"
 	"encoding/hex"
+	"fmt"
 	"os"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 	"github.com/wealdtech/ethereal/cli"
+	"github.com/wealdtech/ethereal/util"
+	ens "github.com/wealdtech/go-ens/v3"
 )
 
 var signatureVerifySignature string
@@ -36,9 +38,16 @@ var signatureVerifyCmd = &cobra.Command{
 
     ethereal data verify --data="false,2,0x5FfC014343cd971B7eb70732021E26C35B744cc4" --types="bool,uint256,address" --signature=0xcefd09e935b867a231086f41d98644655081a6e4e87c43e05fbbf621dfda69ea305c64fcf73907e09ce242c8ab8bcb953c4b45dd78262d8e34b22a8e4309734f00 --signer=0x0x5FfC014343cd971B7eb70732021E26C35B744cc4
 
+    ethereal signature verify --typed-data=mail.json --signature=0x4355c47d63924e8a72e509b65029052eb6c299d53a04e167c5775fd466751c9d07299936d304c153f6443dfa05f40ff007d72911b6f72307f996231605b9156201 --signer=0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826
+
+Signatures are first checked as signatures of an externally owned account.  If this fails and the signer is a contract account, such as a Gnosis Safe, the signature is passed to the account's ERC-1271 isValidSignature(bytes32,bytes) function, or its legacy isValidSignature(bytes,bytes) function.  Signatures wrapped as per EIP-6492 for a contract account that is yet to be deployed are checked by deploying the account through its factory within a call.  Checking a contract signature requires a connection to an Ethereum node, so is only carried out if one is configured with --connection or --network.
+
+The scheme by which the signature is valid is reported: ECDSA, ERC-1271, ERC-1271 (legacy) or EIP-6492.
+
 In quiet mode this will return 0 if the signature is valid, otherwise 1.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		cli.Assert(signatureDataStr != "", quiet, "--data is required")
+		cli.Assert(signatureDataStr != "" || signatureTypedData != "", quiet, "--data or --typed-data is required")
+		cli.Assert(signatureDataStr == "" || signatureTypedData == "", quiet, "only one of --data and --typed-data can be supplied")
 		cli.Assert(signatureVerifySigner != "", quiet, "--signer is required")
 
 		dataHash := generateDataHash()
@@ -46,16 +55,43 @@ In quiet mode this will return 0 if the signature is valid, otherwise 1.`,
 		signature, err := hex.DecodeString(strings.TrimPrefix(signatureVerifySignature, "0x"))
 		cli.ErrCheck(err, quiet, "Invalid signature")
 
-		key, err := crypto.SigToPub(dataHash, []byte(signature))
-		cli.ErrCheck(err, quiet, "Failed to signer signature")
-		cli.Assert(key != nil, quiet, "Invalid signature")
-		signer := crypto.PubkeyToAddress(*key)
+		// A connection is only required for an ENS name or for a signature
+		// that is not from an externally owned account.
+		online := !viper.GetBool("offline")
+		connectIfRequired := func() {
+			if client == nil {
+				err := connect()
+				cli.ErrCheck(err, quiet, "Failed to connect to Ethereum node")
+			}
+		}
+		if !common.IsHexAddress(signatureVerifySigner) {
+			cli.Assert(online, quiet, "Cannot resolve signer when offline")
+			connectIfRequired()
+		}
+		signer, err := ens.Resolve(client, signatureVerifySigner)
+		cli.ErrCheck(err, quiet, "Failed to resolve signer")
 
-		verifySigner := common.HexToAddress(signatureVerifySigner)
+		ctx, cancel := localContext()
+		defer cancel()
+		scheme, err := util.VerifySignature(ctx, nil, signer, dataHash, signature)
+		cli.ErrCheck(err, quiet, "Failed to verify signature")
+		if scheme == "" && online && (client != nil || signatureVerifyConnectionConfigured()) {
+			// The signer could be a contract account.
+			if client == nil {
+				err = connect()
+			}
+			if err == nil {
+				scheme, err = util.VerifySignature(ctx, client, signer, dataHash, signature)
+			}
+			if err != nil {
+				outputIf(debug, fmt.Sprintf("Failed to check contract signer: %v", err))
+				outputIf(!quiet, "Not verified (could not check contract signer)")
+				os.Exit(_exit_failure)
+			}
+		}
 
-		verified := bytes.Compare(signer.Bytes(), verifySigner.Bytes()) == 0
-		if verified {
-			outputIf(!quiet, "Verified")
+		if scheme != "" {
+			outputIf(!quiet, fmt.Sprintf("Verified (%s)", scheme))
 			os.Exit(_exit_success)
 		} else {
 			outputIf(!quiet, "Not verified")
@@ -64,10 +100,19 @@ In quiet mode this will return 0 if the signature is valid, otherwise 1.`,
 	},
 }
 
+// signatureVerifyConnectionConfigured returns true if the user has configured
+// a connection to a node, rather than relying on the default network.
+func signatureVerifyConnectionConfigured() bool {
+	return viper.GetString("connection") != "" ||
+		RootCmd.PersistentFlags().Changed("network") ||
+		viper.InConfig("network") ||
+		os.Getenv("NETWORK") != ""
+}
+
 func init() {
 	offlineCmds["signature:verify"] = true
 	signatureCmd.AddCommand(signatureVerifyCmd)
 	signatureFlags(signatureVerifyCmd)
 	signatureVerifyCmd.Flags().StringVar(&signatureVerifySignature, "signature", "", "Hex string signature from which to verify the signer")
-	signatureVerifyCmd.Flags().StringVar(&signatureVerifySigner, "signer", "", "Address of the signer")
+	signatureVerifyCmd.Flags().StringVar(&signatureVerifySigner, "signer", "", "Address or ENS name of the signer")
 }
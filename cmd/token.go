@@ -14,13 +14,10 @@
 package cmd
 
 import (
-	"fmt"
-	"strings"
-
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/cobra"
+	"github.com/wealdtech/ethereal/util"
 	"github.com/wealdtech/ethereal/util/contracts"
-	ens "github.com/wealdtech/go-ens/v2"
 )
 
 var tokenStr string
@@ -32,20 +29,9 @@ var tokenCmd = &cobra.Command{
 	Long:  `Obtain information, balances and transfer tokens between addresses.`,
 }
 
-var unknownAddress = common.HexToAddress("00")
-
-func tokenContractAddress(input string) (address common.Address, err error) {
-	// Guess 1 - might be an ENS name or a hex string
-	address, err = ens.Resolve(client, input)
-	if (address == unknownAddress || err != nil) && !strings.HasSuffix(input, ".eth") {
-		// Guess 2 - try {input}.thetoken.eth
-		address, err = ens.Resolve(client, input+".thetoken.eth")
-		if err != nil {
-			// Give up
-			err = fmt.Errorf("Unknown token %s", input)
-		}
-	}
-	return
+// Obtain the token contract address given a string
+func tokenContractAddress(input string) (common.Address, error) {
+	return util.TokenAddress(client, input)
 }
 
 // Obtain the token contract given a string
@@ -17,11 +17,15 @@ import (
 	"fmt"
 	"math/big"
 	"os"
+	"strings"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/cobra"
 	"github.com/wealdtech/ethereal/cli"
 	"github.com/wealdtech/ethereal/util"
-	ens "github.com/wealdtech/go-ens/v2"
+	"github.com/wealdtech/ethereal/util/contracts"
+	ens "github.com/wealdtech/go-ens/v3"
 )
 
 var tokenBalanceHolderAddress string
@@ -35,13 +39,20 @@ var tokenBalanceCmd = &cobra.Command{
 
     ethereal token balance --token=omg --holder=0x5FfC014343cd971B7eb70732021E26C35B744cc4
 
-In quiet mode this will return 0 if the balance is greater than 0, otherwise 1.`,
+Balances of multiple tokens can be obtained at once by supplying a comma-separated list of tokens, for example:
+
+    ethereal token balance --token=omg,dai,0xd26114cd6EE289AccF82350c8d8487fedB8A0C07 --holder=0x5FfC014343cd971B7eb70732021E26C35B744cc4
+
+In quiet mode this will return 0 if the balance (or, with multiple tokens, any balance) is greater than 0, otherwise 1.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		cli.Assert(tokenBalanceHolderAddress != "", quiet, "--holder is required")
 		address, err := ens.Resolve(client, tokenBalanceHolderAddress)
 		cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to resolve holder address %s", tokenBalanceHolderAddress))
 
 		cli.Assert(tokenStr != "", quiet, "--token is required")
+		if strings.Contains(tokenStr, ",") {
+			tokenBalances(strings.Split(tokenStr, ","), address)
+		}
 		token, err := tokenContract(tokenStr)
 		cli.ErrCheck(err, quiet, "Failed to obtain token contract")
 
@@ -67,6 +78,56 @@ In quiet mode this will return 0 if the balance is greater than 0, otherwise 1.`
 	},
 }
 
+// tokenBalances outputs the balances of multiple tokens for a holder, using
+// a single multicall to obtain them, and exits.
+func tokenBalances(tokens []string, holder common.Address) {
+	tokenAbi, err := abi.JSON(strings.NewReader(contracts.ERC20ABI))
+	cli.ErrCheck(err, quiet, "Failed to parse token ABI")
+	decimalsData, err := tokenAbi.Pack("decimals")
+	cli.ErrCheck(err, quiet, "Failed to create decimals call")
+	balanceData, err := tokenAbi.Pack("balanceOf", holder)
+	cli.ErrCheck(err, quiet, "Failed to create balance call")
+
+	calls := make([]contracts.Multicall3Call3, 0, len(tokens)*2)
+	for i := range tokens {
+		tokens[i] = strings.TrimSpace(tokens[i])
+		tokenAddress, err := tokenContractAddress(tokens[i])
+		cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to obtain token contract for %s", tokens[i]))
+		calls = append(calls,
+			contracts.Multicall3Call3{Target: tokenAddress, AllowFailure: true, CallData: decimalsData},
+			contracts.Multicall3Call3{Target: tokenAddress, AllowFailure: true, CallData: balanceData},
+		)
+	}
+	ctx, cancel := localContext()
+	defer cancel()
+	results, err := util.Multicall(ctx, client, common.Address{}, calls)
+	cli.ErrCheck(err, quiet, "Failed to obtain token balances")
+
+	found := false
+	for i, token := range tokens {
+		decimalsResult := results[i*2]
+		balanceResult := results[i*2+1]
+		if !decimalsResult.Success || !balanceResult.Success || len(decimalsResult.ReturnData) == 0 || len(balanceResult.ReturnData) == 0 {
+			outputIf(!quiet, fmt.Sprintf("%s\tFailed to obtain balance", token))
+			continue
+		}
+		decimals := uint8(new(big.Int).SetBytes(decimalsResult.ReturnData).Uint64())
+		balance := new(big.Int).SetBytes(balanceResult.ReturnData)
+		if balance.Sign() > 0 {
+			found = true
+		}
+		if tokenBalanceRaw {
+			outputIf(!quiet, fmt.Sprintf("%s\t%s", token, balance.String()))
+		} else {
+			outputIf(!quiet, fmt.Sprintf("%s\t%s", token, util.TokenValueToString(balance, decimals, false)))
+		}
+	}
+	if quiet && !found {
+		os.Exit(_exit_failure)
+	}
+	os.Exit(_exit_success)
+}
+
 func init() {
 	tokenFlags(tokenBalanceCmd)
 	tokenCmd.AddCommand(tokenBalanceCmd)
@@ -20,7 +20,8 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/wealdtech/ethereal/cli"
 	"github.com/wealdtech/ethereal/util"
-	ens "github.com/wealdtech/go-ens/v2"
+	"github.com/wealdtech/ethereal/util/output"
+	ens "github.com/wealdtech/go-ens/v3"
 )
 
 // tokenInfoCmd represents the token info command
@@ -41,6 +42,22 @@ In quiet mode this will return 0 if the token exists, otherwise 1.`,
 			os.Exit(_exit_success)
 		}
 
+		if structuredOutput() {
+			result := &output.TokenInfo{}
+			address, err := tokenContractAddress(tokenStr)
+			cli.ErrCheck(err, quiet, "Failed to obtain token address")
+			result.Address = address.Hex()
+			result.Name, _ = token.Name(nil)
+			result.Symbol, _ = token.Symbol(nil)
+			result.Decimals, _ = token.Decimals(nil)
+			totalSupply, err := token.TotalSupply(nil)
+			if err == nil {
+				result.TotalSupply = totalSupply.String()
+			}
+			outputResult(result)
+			os.Exit(_exit_success)
+		}
+
 		name, err := token.Name(nil)
 		if err == nil {
 			fmt.Printf("Name:\t\t%s\n", name)
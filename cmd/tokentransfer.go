@@ -14,17 +14,21 @@
 package cmd
 
 import (
-	"bytes"
 	"encoding/hex"
 	"fmt"
+	"math/big"
 	"os"
 	"strconv"
+	"strings"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/wealdtech/ethereal/cli"
 	"github.com/wealdtech/ethereal/util"
-	ens "github.com/wealdtech/go-ens/v2"
+	"github.com/wealdtech/ethereal/util/contracts"
+	ens "github.com/wealdtech/go-ens/v3"
 )
 
 var tokenTransferAmount string
@@ -40,12 +44,31 @@ var tokenTransferCmd = &cobra.Command{
 
     ethereal token transfer --token=omg --from=0x5FfC014343cd971B7eb70732021E26C35B744cc4 --to=0x52f1A3027d3aA514F17E454C93ae1F79b3B12d5d --amount=10 --passphrase=secret
 
-This will return an exit status of 0 if the transaction is successfully submitted (and mined if --wait is supplied), 1 if the transaction is not successfully submitted, and 2 if the transaction is successfully submitted but not mined within the supplied time limit.`,
+Multiple transfers can be sent at once by supplying a CSV file with one transfer per line in place of --to and --amount, for example:
+
+    ethereal token transfer --token=omg --from=0x5FfC014343cd971B7eb70732021E26C35B744cc4 --batch=airdrop.csv --passphrase=secret
+
+Each line of the file contains the recipient, the amount and optionally a memo, which is recorded in the results but not sent.  All transfers are validated before any are sent, and the results are written to --batchresults (default the batch file with a .results suffix).  Running the same command again resumes the batch, skipping transfers that have already been submitted.
+
+This will return an exit status of 0 if the transaction is successfully submitted (and mined if --wait is supplied), 1 if the transaction is not successfully submitted, and 2 if the transaction is successfully submitted but not mined within the supplied time limit.  For a batch the status covers all of its transfers.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		cli.Assert(tokenTransferFromAddress != "", quiet, "--from is required")
 		fromAddress, err := ens.Resolve(client, tokenTransferFromAddress)
 		cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to resolve from address %s", tokenTransferFromAddress))
 
+		if batchFile != "" {
+			cli.Assert(tokenTransferToAddress == "" && tokenTransferAmount == "", quiet, "--to and --amount cannot be supplied with --batch")
+			cli.Assert(tokenStr != "", quiet, "--token is required")
+			spec, err := tokenBatchSpec(fromAddress)
+			cli.ErrCheck(err, quiet, "Failed to obtain token contract")
+			runBatchTransfers(fromAddress, spec, log.Fields{
+				"group":       "token",
+				"command":     "transfer",
+				"token":       tokenStr,
+				"tokenholder": fromAddress.Hex(),
+			})
+		}
+
 		cli.Assert(tokenTransferToAddress != "", quiet, "--to is required")
 		toAddress, err := ens.Resolve(client, tokenTransferToAddress)
 		cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to resolve to address %s", tokenTransferToAddress))
@@ -85,9 +108,9 @@ This will return an exit status of 0 if the transaction is successfully submitte
 
 		if offline {
 			if !quiet {
-				buf := new(bytes.Buffer)
-				signedTx.EncodeRLP(buf)
-				fmt.Printf("0x%s\n", hex.EncodeToString(buf.Bytes()))
+				data, err := signedTx.MarshalBinary()
+				cli.ErrCheck(err, quiet, "Failed to marshal transaction")
+				fmt.Printf("0x%s\n", hex.EncodeToString(data))
 			}
 			os.Exit(_exit_success)
 		}
@@ -103,6 +126,46 @@ This will return an exit status of 0 if the transaction is successfully submitte
 	},
 }
 
+// tokenBatchSpec describes batch transfers of tokens.
+func tokenBatchSpec(fromAddress common.Address) (*batchSpec, error) {
+	tokenAddress, err := tokenContractAddress(tokenStr)
+	if err != nil {
+		return nil, err
+	}
+	token, err := contracts.NewERC20(tokenAddress, client)
+	if err != nil {
+		return nil, err
+	}
+	decimals, err := token.Decimals(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to obtain token decimals: %v", err)
+	}
+	balance, err := token.BalanceOf(nil, fromAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to obtain token balance: %v", err)
+	}
+	tokenAbi, err := abi.JSON(strings.NewReader(contracts.ERC20ABI))
+	if err != nil {
+		return nil, err
+	}
+	return &batchSpec{
+		balance: balance,
+		parseAmount: func(input string) (*big.Int, error) {
+			return util.StringToTokenValue(input, decimals)
+		},
+		formatAmount: func(amount *big.Int) string {
+			return util.TokenValueToString(amount, decimals, false)
+		},
+		txParams: func(to common.Address, amount *big.Int, _ string) (*common.Address, *big.Int, []byte, error) {
+			data, err := tokenAbi.Pack("transfer", to, amount)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			return &tokenAddress, big.NewInt(0), data, nil
+		},
+	}, nil
+}
+
 func init() {
 	tokenCmd.AddCommand(tokenTransferCmd)
 	tokenFlags(tokenTransferCmd)
@@ -110,5 +173,6 @@ func init() {
 	tokenTransferCmd.Flags().StringVar(&tokenTransferFromAddress, "from", "", "Address from which to transfer tokens")
 	tokenTransferCmd.Flags().StringVar(&tokenTransferToAddress, "to", "", "Address to which to transfer tokens")
 	tokenTransferCmd.Flags().StringVar(&tokenTransferDecimals, "decimals", "18", "Number of decimals for the transfer (only required if offline)")
+	addBatchFlags(tokenTransferCmd)
 	addTransactionFlags(tokenTransferCmd, "the address from which to transfer tokens")
 }
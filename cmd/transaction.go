@@ -14,7 +14,18 @@
 package cmd
 
 import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/wealdtech/ethereal/util"
+	"github.com/wealdtech/ethereal/util/txdata"
+	string2eth "github.com/wealdtech/go-string2eth"
 )
 
 var transactionStr string
@@ -33,3 +44,82 @@ func init() {
 func transactionFlags(cmd *cobra.Command) {
 	cmd.Flags().StringVarP(&transactionStr, "transaction", "t", "", "raw transaction data or ID of the transaction")
 }
+
+// setReplacementFees sets the fees for a transaction that replaces the supplied
+// transaction.  The replacement uses the same fee model as the original.  Fees
+// that are not supplied by the user default to the minimum required for
+// replacement; fees that are supplied must be at least this minimum, and are
+// never increased.
+func setReplacementFees(tx *types.Transaction) error {
+	if tx.Type() == types.DynamicFeeTxType {
+		if viper.GetString("gasprice") != "" {
+			return fmt.Errorf("transaction uses dynamic fees; use --maxfeepergas and --maxpriorityfeepergas")
+		}
+		minFeeCap := util.MinReplacementFee(tx.GasFeeCap())
+		minTipCap := util.MinReplacementFee(tx.GasTipCap())
+		feeCapSupplied := maxFeePerGas != nil
+		if !feeCapSupplied {
+			maxFeePerGas = minFeeCap
+		} else if maxFeePerGas.Cmp(minFeeCap) < 0 {
+			return fmt.Errorf("max fee per gas must be at least %s", string2eth.WeiToString(minFeeCap, true))
+		}
+		if maxPriorityFeePerGas == nil {
+			maxPriorityFeePerGas = minTipCap
+		} else if maxPriorityFeePerGas.Cmp(minTipCap) < 0 {
+			return fmt.Errorf("max priority fee per gas must be at least %s", string2eth.WeiToString(minTipCap, true))
+		}
+		if maxPriorityFeePerGas.Cmp(maxFeePerGas) > 0 {
+			if feeCapSupplied {
+				return fmt.Errorf("max priority fee per gas %s is higher than max fee per gas %s", string2eth.WeiToString(maxPriorityFeePerGas, true), string2eth.WeiToString(maxFeePerGas, true))
+			}
+			maxFeePerGas = new(big.Int).Set(maxPriorityFeePerGas)
+		}
+		dynamicFees = true
+	} else {
+		if maxFeePerGas != nil || maxPriorityFeePerGas != nil {
+			return fmt.Errorf("transaction uses a gas price; use --gasprice")
+		}
+		minGasPrice := util.MinReplacementFee(tx.GasPrice())
+		if gasPrice == nil {
+			gasPrice = minGasPrice
+		} else if gasPrice.Cmp(minGasPrice) < 0 {
+			return fmt.Errorf("gas price must be at least %s", string2eth.WeiToString(minGasPrice, true))
+		}
+		dynamicFees = false
+	}
+	feesConfigured = true
+	return nil
+}
+
+// txTypeName returns a human-readable name for a transaction type.
+func txTypeName(txType uint8) string {
+	switch txType {
+	case types.LegacyTxType:
+		return "Legacy"
+	case types.AccessListTxType:
+		return "Access list (EIP-2930)"
+	case types.DynamicFeeTxType:
+		return "Dynamic fee (EIP-1559)"
+	default:
+		return fmt.Sprintf("Unknown (%d)", txType)
+	}
+}
+
+// callRevertReason makes the supplied call at the given block (nil for the
+// latest block) and reports if it reverts, along with the decoded revert reason
+// if available.  Errors other than reverts are returned as-is.
+func callRevertReason(msg ethereum.CallMsg, blockNumber *big.Int, contractAbi *abi.ABI) (bool, string, error) {
+	ctx, cancel := localContext()
+	defer cancel()
+	_, err := client.CallContract(ctx, msg, blockNumber)
+	if err == nil {
+		return false, "", nil
+	}
+	if data, exists := util.RevertData(err); exists {
+		return true, txdata.RevertToString(client, contractAbi, data), nil
+	}
+	if strings.Contains(err.Error(), "execution reverted") {
+		return true, "", nil
+	}
+	return false, "", err
+}
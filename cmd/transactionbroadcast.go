@@ -0,0 +1,63 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/wealdtech/ethereal/cli"
+)
+
+// transactionBroadcastCmd represents the transaction broadcast command
+var transactionBroadcastCmd = &cobra.Command{
+	Use:   "broadcast",
+	Short: "Broadcast a signed transaction",
+	Long: `Broadcast a signed transaction to the network.  For example:
+
+    ethereal transaction broadcast --transaction=0x02f8...
+
+This will return an exit status of 0 if the transaction is successfully submitted (and mined if --wait is supplied), 1 if the transaction is not successfully submitted, and 2 if the transaction is successfully submitted but not mined within the supplied time limit.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli.Assert(transactionStr != "", quiet, "--transaction is required")
+		data, err := hex.DecodeString(strings.TrimPrefix(transactionStr, "0x"))
+		cli.ErrCheck(err, quiet, "Failed to decode data")
+		signedTx := &types.Transaction{}
+		err = signedTx.UnmarshalBinary(data)
+		cli.ErrCheck(err, quiet, "Failed to decode transaction")
+		_, err = txFrom(signedTx)
+		cli.ErrCheck(err, quiet, "Transaction is not signed")
+
+		ctx, cancel := localContext()
+		defer cancel()
+		err = client.SendTransaction(ctx, signedTx)
+		cli.ErrCheck(err, quiet, "Failed to send transaction")
+
+		handleSubmittedTransaction(signedTx, log.Fields{
+			"group":   "transaction",
+			"command": "broadcast",
+		}, true)
+	},
+}
+
+func init() {
+	transactionCmd.AddCommand(transactionBroadcastCmd)
+	transactionFlags(transactionBroadcastCmd)
+	transactionBroadcastCmd.Flags().Bool("wait", false, "wait for the transaction to be mined before returning")
+	transactionBroadcastCmd.Flags().Duration("limit", 0, "maximum time to wait for transaction to complete before failing (default forever)")
+	transactionBroadcastCmd.Flags().Uint64("confirmations", 1, "when waiting, number of blocks in which the transaction must be included before it is considered mined")
+}
@@ -14,18 +14,14 @@
 package cmd
 
 import (
-	"bytes"
 	"encoding/hex"
 	"fmt"
-	"math/big"
 	"os"
 
 	"github.com/ethereum/go-ethereum/common"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 	"github.com/wealdtech/ethereal/cli"
-	string2eth "github.com/wealdtech/go-string2eth"
 )
 
 var transactionCancelAmount string
@@ -39,7 +35,7 @@ var transactionCancelCmd = &cobra.Command{
 
     ethereal transaction cancel --transaction=0x454d2274155cce506359de6358785ce5366f6c13e825263674c272eec8532c0c
 
-Note that Ethereum does not have the ability to cancel a pending transaction, so this overwrites the pending transaction with a 0-value transfer back to the address sender.  It will, however, still need to be mined so choose an appropriate gas price.  If not supplied then the gas price will default to just over 10% higher than the gas price of the transaction to be cancelled.
+Note that Ethereum does not have the ability to cancel a pending transaction, so this overwrites the pending transaction with a 0-value transfer back to the address sender.  It will, however, still need to be mined so choose an appropriate gas price.  If not supplied then the gas price will default to just over 10% higher than the gas price of the transaction to be cancelled.  If the transaction to be cancelled uses dynamic fees then the cancellation will also use dynamic fees, with both max fee per gas and max priority fee per gas defaulting to just over 10% higher than those of the transaction to be cancelled.
 
 The cancellation transaction will cost 21000 gas.
 
@@ -53,14 +49,8 @@ This will return an exit status of 0 if the transaction is successfully submitte
 		cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to obtain transaction %s", txHash.Hex()))
 		cli.Assert(pending, quiet, fmt.Sprintf("Transaction %s has already been mined", txHash.Hex()))
 
-		minGasPrice := new(big.Int).Add(new(big.Int).Add(tx.GasPrice(), new(big.Int).Div(tx.GasPrice(), big.NewInt(10))), big.NewInt(1))
-		if viper.GetString("gasprice") == "" {
-			// No gas price supplied; use the calculated minimum
-			gasPrice = minGasPrice
-		} else {
-			// Gas price supplied; ensure it is over 10% more than the current gas price
-			cli.Assert(gasPrice.Cmp(minGasPrice) > 0, quiet, fmt.Sprintf("Gas price must be at least %s", string2eth.WeiToString(minGasPrice, true)))
-		}
+		err = setReplacementFees(tx)
+		cli.ErrCheck(err, quiet, "Failed to set fees for replacement transaction")
 
 		// Create and sign the transaction
 		fromAddress, err := txFrom(tx)
@@ -72,9 +62,9 @@ This will return an exit status of 0 if the transaction is successfully submitte
 
 		if offline {
 			if !quiet {
-				buf := new(bytes.Buffer)
-				signedTx.EncodeRLP(buf)
-				fmt.Printf("0x%s\n", hex.EncodeToString(buf.Bytes()))
+				data, err := signedTx.MarshalBinary()
+				cli.ErrCheck(err, quiet, "Failed to marshal transaction")
+				fmt.Printf("0x%s\n", hex.EncodeToString(data))
 			}
 			os.Exit(_exit_success)
 		}
@@ -0,0 +1,171 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/wealdtech/ethereal/cli"
+	"github.com/wealdtech/ethereal/util"
+)
+
+// transactionEscalateCmd represents the transaction escalate command
+var transactionEscalateCmd = &cobra.Command{
+	Use:   "escalate",
+	Short: "Escalate the fees of a pending transaction until it is mined",
+	Long: `Repeatedly replace a pending transaction with one paying higher fees until it is mined.  For example:
+
+    ethereal transaction escalate --transaction=0x454d2274155cce506359de6358785ce5366f6c13e825263674c272eec8532c0c --escalatestep=20 --escalateinterval=2m --escalatecap=200gwei --passphrase=secret
+
+Each replacement increases the fees by --escalatestep percent (minimum 10), and is sent if the previous version has not been mined after --escalateinterval.  Fees will not be increased beyond --escalatecap if supplied.
+
+This will return an exit status of 0 if the transaction is mined, 1 if the transaction cannot be escalated, and 2 if the transaction is not mined within the supplied time limit.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli.Assert(!offline, quiet, "Offline mode not supported at current with this command")
+		cli.Assert(transactionStr != "", quiet, "--transaction is required")
+		txHash := common.HexToHash(transactionStr)
+		ctx, cancel := localContext()
+		defer cancel()
+		tx, pending, err := client.TransactionByHash(ctx, txHash)
+		cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to obtain transaction %s", txHash.Hex()))
+		cli.Assert(pending, quiet, fmt.Sprintf("Transaction %s has already been mined", txHash.Hex()))
+
+		receipt, mined := escalateTransaction(tx, log.Fields{
+			"group":   "transaction",
+			"command": "escalate",
+		})
+		if !mined {
+			outputIf(!quiet, fmt.Sprintf("%s not mined", txHash.Hex()))
+			os.Exit(_exit_not_mined)
+		}
+		if receipt.TxHash == txHash {
+			outputIf(!quiet, fmt.Sprintf("%s mined", receipt.TxHash.Hex()))
+		} else {
+			outputIf(!quiet, fmt.Sprintf("%s mined (replacing %s)", receipt.TxHash.Hex(), txHash.Hex()))
+		}
+		outputReceipt(receipt)
+		if receipt.Status == types.ReceiptStatusFailed {
+			os.Exit(_exit_failure)
+		}
+		os.Exit(_exit_success)
+	},
+}
+
+// escalateTransaction waits for a transaction to be mined, replacing it with
+// versions paying increasing fees according to the escalation flags until one
+// of them is mined or the limit expires.  It returns the receipt of the
+// version that was mined with the required number of confirmations.
+func escalateTransaction(tx *types.Transaction, logFields log.Fields) (*types.Receipt, bool) {
+	fromAddress, err := txFrom(tx)
+	cli.ErrCheck(err, quiet, "Failed to obtain from address")
+
+	step := uint64(viper.GetInt("escalatestep"))
+	interval := viper.GetDuration("escalateinterval")
+	limit := viper.GetDuration("limit")
+	confirmations := viper.GetUint64("confirmations")
+
+	hashes := []common.Hash{tx.Hash()}
+	current := tx
+	capped := false
+	start := time.Now()
+	for {
+		wait := interval
+		if limit != 0 {
+			remaining := limit - time.Since(start)
+			if remaining <= 0 {
+				return nil, false
+			}
+			if remaining < wait {
+				wait = remaining
+			}
+		}
+		if receipt, mined := util.WaitForTransactions(client, hashes, 1, wait); mined {
+			outputIf(verbose && len(hashes) > 1, fmt.Sprintf("Mined version %s of %d", receipt.TxHash.Hex(), len(hashes)))
+			return waitForConfirmations(hashes, confirmations, limit, start)
+		}
+
+		// Ensure that the nonce has not been used by a transaction we are not tracking
+		ctx, cancel := localContext()
+		accountNonce, err := client.NonceAt(ctx, fromAddress, nil)
+		cancel()
+		if err == nil && accountNonce > tx.Nonce() {
+			if _, mined := util.WaitForTransactions(client, hashes, 1, time.Second); mined {
+				return waitForConfirmations(hashes, confirmations, limit, start)
+			}
+			outputIf(!quiet, fmt.Sprintf("Nonce %d used by another transaction", tx.Nonce()))
+			return nil, false
+		}
+
+		if capped {
+			continue
+		}
+		replacement, err := util.EscalateTransaction(current, chainID, step, escalateCap)
+		if err != nil {
+			outputIf(verbose, fmt.Sprintf("Not escalating %s: %v", current.Hash().Hex(), err))
+			capped = true
+			continue
+		}
+		signedTx, err := signTransaction(fromAddress, replacement)
+		cli.ErrCheck(err, quiet, "Failed to sign replacement transaction")
+		ctx, cancel = localContext()
+		err = client.SendTransaction(ctx, signedTx)
+		cancel()
+		if err != nil {
+			// Most likely a version has been mined in the meantime, which will be picked up next time round
+			outputIf(verbose, fmt.Sprintf("Failed to send replacement for %s: %v", current.Hash().Hex(), err))
+			continue
+		}
+
+		fields := log.Fields{"oldtransactionid": current.Hash().Hex()}
+		for k, v := range logFields {
+			fields[k] = v
+		}
+		logTransaction(signedTx, fields)
+		outputIf(verbose, fmt.Sprintf("%s replaced by %s", current.Hash().Hex(), signedTx.Hash().Hex()))
+
+		hashes = append(hashes, signedTx.Hash())
+		current = signedTx
+	}
+}
+
+// waitForConfirmations waits for one of a set of mined transactions to obtain
+// the required number of confirmations within what remains of the limit.
+func waitForConfirmations(hashes []common.Hash, confirmations uint64, limit time.Duration, start time.Time) (*types.Receipt, bool) {
+	if limit == 0 {
+		return util.WaitForTransactions(client, hashes, confirmations, 0)
+	}
+	remaining := limit - time.Since(start)
+	if remaining <= 0 {
+		remaining = time.Second
+	}
+	return util.WaitForTransactions(client, hashes, confirmations, remaining)
+}
+
+func init() {
+	transactionCmd.AddCommand(transactionEscalateCmd)
+	transactionFlags(transactionEscalateCmd)
+	transactionEscalateCmd.Flags().String("passphrase", "", "passphrase for the address that sent the transaction")
+	transactionEscalateCmd.Flags().String("privatekey", "", "private key for the address that sent the transaction")
+	addMnemonicFlags(transactionEscalateCmd, "the address that sent the transaction")
+	transactionEscalateCmd.Flags().Duration("limit", 0, "maximum time to wait for transaction to complete before failing (default forever)")
+	transactionEscalateCmd.Flags().Uint64("confirmations", 1, "number of blocks in which the transaction must be included before it is considered mined")
+	addEscalationFlags(transactionEscalateCmd)
+}
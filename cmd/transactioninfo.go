@@ -14,25 +14,28 @@
 package cmd
 
 import (
-	"bytes"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"math/big"
 	"os"
 	"strings"
 
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/spf13/cobra"
 	"github.com/wealdtech/ethereal/cli"
 	"github.com/wealdtech/ethereal/util/txdata"
-	ens "github.com/wealdtech/go-ens/v2"
+	ens "github.com/wealdtech/go-ens/v3"
 	string2eth "github.com/wealdtech/go-string2eth"
 )
 
 var transactionInfoRaw bool
 var transactionInfoJSON bool
 var transactionInfoSignatures string
+var transactionInfoAbi string
 
 // transactionInfoCmd represents the transaction info command
 var transactionInfoCmd = &cobra.Command{
@@ -47,16 +50,25 @@ In quiet mode this will return 0 if the transaction exists, otherwise 1.`,
 		cli.Assert(transactionStr != "", quiet, "--transaction is required")
 		var txHash common.Hash
 		var pending bool
+		var unsubmitted bool
 		var tx *types.Transaction
 		if len(transactionStr) > 66 {
-			// Assume input is a raw transaction
+			// Assume input is a raw transaction, either legacy or typed
 			data, err := hex.DecodeString(strings.TrimPrefix(transactionStr, "0x"))
 			cli.ErrCheck(err, quiet, "Failed to decode data")
 			tx = &types.Transaction{}
-			stream := rlp.NewStream(bytes.NewReader(data), 0)
-			err = tx.DecodeRLP(stream)
+			err = tx.UnmarshalBinary(data)
 			cli.ErrCheck(err, quiet, "Failed to decode raw transaction")
 			txHash = tx.Hash()
+			// See if the transaction has been submitted
+			ctx, cancel := localContext()
+			defer cancel()
+			_, pending, err = client.TransactionByHash(ctx, txHash)
+			if errors.Is(err, ethereum.NotFound) {
+				unsubmitted = true
+			} else {
+				cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to obtain transaction %s", txHash.Hex()))
+			}
 		} else {
 			// Assume input is a transaction ID
 			txHash = common.HexToHash(transactionStr)
@@ -72,9 +84,9 @@ In quiet mode this will return 0 if the transaction exists, otherwise 1.`,
 		}
 
 		if transactionInfoRaw {
-			buf := new(bytes.Buffer)
-			tx.EncodeRLP(buf)
-			fmt.Printf("0x%s\n", hex.EncodeToString(buf.Bytes()))
+			data, err := tx.MarshalBinary()
+			cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to obtain raw transaction %s", txHash.Hex()))
+			fmt.Printf("0x%s\n", hex.EncodeToString(data))
 			os.Exit(_exit_success)
 		}
 
@@ -91,9 +103,21 @@ In quiet mode this will return 0 if the transaction exists, otherwise 1.`,
 				txdata.AddFunctionSignature(signature)
 			}
 		}
+		var infoAbi *abi.ABI
+		if transactionInfoAbi != "" {
+			tmp, err := contractParseAbi(transactionInfoAbi)
+			cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to parse ABI %s", transactionInfoAbi))
+			infoAbi = &tmp
+		}
 
 		var receipt *types.Receipt
-		if pending {
+		if unsubmitted {
+			if tx.To() == nil {
+				fmt.Printf("Type:\t\t\tUnsubmitted contract creation\n")
+			} else {
+				fmt.Printf("Type:\t\t\tUnsubmitted transaction\n")
+			}
+		} else if pending {
 			if tx.To() == nil {
 				fmt.Printf("Type:\t\t\tPending contract creation\n")
 			} else {
@@ -111,6 +135,7 @@ In quiet mode this will return 0 if the transaction exists, otherwise 1.`,
 			if receipt != nil {
 				if receipt.Status == 0 {
 					fmt.Printf("Result:\t\t\tFailed\n")
+					fmt.Printf("Reason:\t\t\t%s\n", transactionInfoFailureReason(tx, receipt, infoAbi))
 				} else {
 					fmt.Printf("Result:\t\t\tSucceeded\n")
 				}
@@ -136,6 +161,10 @@ In quiet mode this will return 0 if the transaction exists, otherwise 1.`,
 			fmt.Printf("To:\t\t\t%v\n", ens.Format(client, *tx.To()))
 		}
 
+		fmt.Printf("Transaction type:\t%s\n", txTypeName(tx.Type()))
+		if tx.Type() != types.LegacyTxType || tx.Protected() {
+			fmt.Printf("Chain ID:\t\t%v\n", tx.ChainId())
+		}
 		if verbose {
 			fmt.Printf("Nonce:\t\t\t%v\n", tx.Nonce())
 			fmt.Printf("Gas limit:\t\t%v\n", tx.Gas())
@@ -143,13 +172,44 @@ In quiet mode this will return 0 if the transaction exists, otherwise 1.`,
 		if receipt != nil {
 			fmt.Printf("Gas used:\t\t%v\n", receipt.GasUsed)
 		}
-		fmt.Printf("Gas price:\t\t%v\n", string2eth.WeiToString(tx.GasPrice(), true))
+		if tx.Type() == types.DynamicFeeTxType {
+			fmt.Printf("Max fee per gas:\t%v\n", string2eth.WeiToString(tx.GasFeeCap(), true))
+			fmt.Printf("Max priority fee:\t%v\n", string2eth.WeiToString(tx.GasTipCap(), true))
+			if receipt != nil && receipt.BlockNumber != nil {
+				ctx, cancel := localContext()
+				defer cancel()
+				header, err := client.HeaderByNumber(ctx, receipt.BlockNumber)
+				if err == nil && header.BaseFee != nil {
+					effectiveGasPrice := new(big.Int).Add(header.BaseFee, tx.GasTipCap())
+					if effectiveGasPrice.Cmp(tx.GasFeeCap()) > 0 {
+						effectiveGasPrice = tx.GasFeeCap()
+					}
+					fmt.Printf("Gas price:\t\t%v\n", string2eth.WeiToString(effectiveGasPrice, true))
+				}
+			}
+		} else {
+			fmt.Printf("Gas price:\t\t%v\n", string2eth.WeiToString(tx.GasPrice(), true))
+		}
 		fmt.Printf("Value:\t\t\t%v\n", string2eth.WeiToString(tx.Value(), true))
 
 		if tx.To() != nil && len(tx.Data()) > 0 {
 			fmt.Printf("Data:\t\t\t%v\n", txdata.DataToString(client, tx.Data()))
 		}
 
+		if tx.Type() != types.LegacyTxType && len(tx.AccessList()) > 0 {
+			fmt.Printf("Access list:\n")
+			for i, tuple := range tx.AccessList() {
+				fmt.Printf("\t%d:\n", i)
+				fmt.Printf("\t\tAddress:\t%v\n", ens.Format(client, tuple.Address))
+				if len(tuple.StorageKeys) > 0 {
+					fmt.Printf("\t\tStorage keys:\n")
+					for j, key := range tuple.StorageKeys {
+						fmt.Printf("\t\t\t%d:\t%v\n", j, key.Hex())
+					}
+				}
+			}
+		}
+
 		if verbose && receipt != nil && len(receipt.Logs) > 0 {
 			fmt.Printf("Logs:\n")
 			for i, log := range receipt.Logs {
@@ -178,10 +238,40 @@ In quiet mode this will return 0 if the transaction exists, otherwise 1.`,
 	},
 }
 
+// transactionInfoFailureReason replays a failed transaction at its parent block
+// to obtain the reason for its failure.
+func transactionInfoFailureReason(tx *types.Transaction, receipt *types.Receipt, contractAbi *abi.ABI) string {
+	fromAddress, err := txFrom(tx)
+	if err != nil || receipt.BlockNumber == nil || receipt.BlockNumber.Sign() == 0 {
+		return "unknown"
+	}
+	msg := ethereum.CallMsg{
+		From:       fromAddress,
+		To:         tx.To(),
+		Gas:        tx.Gas(),
+		Value:      tx.Value(),
+		Data:       tx.Data(),
+		AccessList: tx.AccessList(),
+	}
+	reverted, reason, err := callRevertReason(msg, new(big.Int).Sub(receipt.BlockNumber, big.NewInt(1)), contractAbi)
+	if err != nil {
+		return err.Error()
+	}
+	if !reverted {
+		// Succeeds at the parent block so the failure depends on earlier transactions in the block
+		return "unknown"
+	}
+	if reason == "" {
+		return "reverted without a reason"
+	}
+	return reason
+}
+
 func init() {
 	transactionCmd.AddCommand(transactionInfoCmd)
 	transactionFlags(transactionInfoCmd)
 	transactionInfoCmd.Flags().BoolVar(&transactionInfoRaw, "raw", false, "Output the transaction as raw hex")
 	transactionInfoCmd.Flags().BoolVar(&transactionInfoJSON, "json", false, "Output the transaction as json")
+	transactionInfoCmd.Flags().StringVar(&transactionInfoAbi, "abi", "", "ABI, or path to ABI, for the contract; used to decode custom errors")
 	transactionInfoCmd.Flags().StringVar(&transactionInfoSignatures, "signatures", "", "Semicolon-separated list of custom transaction signatures (e.g. myFunc(address,bytes32);myFunc2(bool)")
 }
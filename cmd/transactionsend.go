@@ -14,7 +14,6 @@
 package cmd
 
 import (
-	"bytes"
 	"encoding/hex"
 	"fmt"
 	"math/big"
@@ -23,11 +22,10 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/rlp"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/wealdtech/ethereal/cli"
-	ens "github.com/wealdtech/go-ens/v2"
+	ens "github.com/wealdtech/go-ens/v3"
 	string2eth "github.com/wealdtech/go-string2eth"
 )
 
@@ -55,8 +53,7 @@ This will return an exit status of 0 if the transaction is successfully submitte
 			data, err := hex.DecodeString(strings.TrimPrefix(transactionSendRaw, "0x"))
 			cli.ErrCheck(err, quiet, "Failed to decode data")
 			signedTx := &types.Transaction{}
-			stream := rlp.NewStream(bytes.NewReader(data), 0)
-			err = signedTx.DecodeRLP(stream)
+			err = signedTx.UnmarshalBinary(data)
 			cli.ErrCheck(err, quiet, "Failed to decode transaction")
 
 			ctx, cancel := localContext()
@@ -122,9 +119,9 @@ This will return an exit status of 0 if the transaction is successfully submitte
 
 			if offline {
 				if !quiet {
-					buf := new(bytes.Buffer)
-					signedTx.EncodeRLP(buf)
-					fmt.Printf("0x%s\n", hex.EncodeToString(buf.Bytes()))
+					data, err := signedTx.MarshalBinary()
+					cli.ErrCheck(err, quiet, "Failed to marshal transaction")
+					fmt.Printf("0x%s\n", hex.EncodeToString(data))
 				}
 				os.Exit(_exit_success)
 			}
@@ -150,4 +147,5 @@ func init() {
 	transactionSendCmd.Flags().StringVar(&transactionSendRaw, "raw", "", "raw transaction (as a hex string).  This overrides all other options")
 	transactionSendCmd.Flags().IntVar(&transactionSendRepeat, "repeat", 1, "Number of times to repeat sending the transaction (incrementing the nonce each time)")
 	addTransactionFlags(transactionSendCmd, "the address from which to transfer Ether")
+	addAccessListFlag(transactionSendCmd)
 }
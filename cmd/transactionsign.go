@@ -0,0 +1,73 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wealdtech/ethereal/cli"
+	"github.com/wealdtech/ethereal/util"
+)
+
+var transactionSignUnsigned string
+
+// transactionSignCmd represents the transaction sign command
+var transactionSignCmd = &cobra.Command{
+	Use:   "sign",
+	Short: "Sign an unsigned transaction",
+	Long: `Sign an unsigned transaction created with the --unsigned flag.  For example:
+
+    ethereal transaction sign --unsigned=/path/to/tx.json --passphrase=secret
+
+The unsigned transaction can be supplied either as JSON or as the name of a file containing the JSON.  The signed transaction is output as a hex string, suitable for 'transaction broadcast'.  This command does not access the network.
+
+In quiet mode this will return 0 if the transaction is signed, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli.Assert(transactionSignUnsigned != "", quiet, "--unsigned is required")
+		unsignedTx, err := util.ParseUnsignedTransaction(transactionSignUnsigned)
+		cli.ErrCheck(err, quiet, "Failed to parse unsigned transaction")
+		tx, err := unsignedTx.Transaction()
+		cli.ErrCheck(err, quiet, "Invalid unsigned transaction")
+
+		// Chain ID comes from the transaction rather than the network
+		chainID, err = unsignedTx.ChainIDInt()
+		cli.ErrCheck(err, quiet, "Invalid chain ID")
+
+		signedTx, err := signTransaction(unsignedTx.From, tx)
+		cli.ErrCheck(err, quiet, "Failed to sign transaction")
+		signer, err := txFrom(signedTx)
+		cli.ErrCheck(err, quiet, "Failed to obtain signer of transaction")
+		cli.Assert(signer == unsignedTx.From, quiet, fmt.Sprintf("Transaction signed by %s rather than %s", signer.Hex(), unsignedTx.From.Hex()))
+
+		if quiet {
+			os.Exit(_exit_success)
+		}
+
+		data, err := signedTx.MarshalBinary()
+		cli.ErrCheck(err, quiet, "Failed to marshal transaction")
+		fmt.Printf("0x%s\n", hex.EncodeToString(data))
+	},
+}
+
+func init() {
+	offlineCmds["transaction:sign"] = true
+	transactionCmd.AddCommand(transactionSignCmd)
+	transactionSignCmd.Flags().StringVar(&transactionSignUnsigned, "unsigned", "", "Unsigned transaction, as JSON or the name of a file containing the JSON")
+	transactionSignCmd.Flags().String("passphrase", "", "Passphrase for the address that signs the transaction")
+	transactionSignCmd.Flags().String("privatekey", "", "Private key for the address that signs the transaction")
+	addMnemonicFlags(transactionSignCmd, "the address that signs the transaction")
+}
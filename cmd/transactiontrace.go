@@ -0,0 +1,130 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+	"github.com/wealdtech/ethereal/cli"
+	"github.com/wealdtech/ethereal/util"
+	"github.com/wealdtech/ethereal/util/txdata"
+	ens "github.com/wealdtech/go-ens/v3"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+var transactionTraceSignatures string
+
+// transactionTraceCmd represents the transaction trace command
+var transactionTraceCmd = &cobra.Command{
+	Use:   "trace",
+	Short: "Show the call tree of a transaction",
+	Long: `Show the internal call tree of a mined transaction.  For example:
+
+    ethereal transaction trace --transaction=0x581560df6b07612293996772a40966e8b85f70af2d53eee624513324fad8a99a
+
+This requires the connected node to provide the debug_traceTransaction call with the callTracer.
+
+In quiet mode this will return 0 if the transaction can be traced, otherwise 1.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli.Assert(transactionStr != "", quiet, "--transaction is required")
+		txHash := common.HexToHash(transactionStr)
+
+		ctx, cancel := localContext()
+		defer cancel()
+		frame, err := util.TraceTransaction(ctx, rpcClient, txHash)
+		cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to trace transaction %s", txHash.Hex()))
+
+		if quiet {
+			os.Exit(_exit_success)
+		}
+
+		txdata.InitFunctionMap()
+		if transactionTraceSignatures != "" {
+			for _, signature := range strings.Split(transactionTraceSignatures, ";") {
+				txdata.AddFunctionSignature(signature)
+			}
+		}
+
+		printCallFrame(frame, 0)
+	},
+}
+
+// printCallFrame prints a call frame and its children
+func printCallFrame(frame *util.CallFrame, depth int) {
+	indent := strings.Repeat("  ", depth)
+	if frame.To == nil {
+		fmt.Printf("%s%s %v\n", indent, frame.Type, ens.Format(client, frame.From))
+	} else {
+		fmt.Printf("%s%s %v → %v\n", indent, frame.Type, ens.Format(client, frame.From), ens.Format(client, *frame.To))
+	}
+	if frame.Value != nil && frame.Value.ToInt().Sign() != 0 {
+		fmt.Printf("%s  Value:\t%v\n", indent, string2eth.WeiToString(frame.Value.ToInt(), true))
+	}
+	if verbose {
+		fmt.Printf("%s  Gas:\t\t%d\n", indent, uint64(frame.Gas))
+	}
+	fmt.Printf("%s  Gas used:\t%d\n", indent, uint64(frame.GasUsed))
+	if len(frame.Input) > 0 {
+		if strings.HasPrefix(frame.Type, "CREATE") {
+			if verbose {
+				fmt.Printf("%s  Code:\t\t%#x\n", indent, []byte(frame.Input))
+			}
+		} else {
+			fmt.Printf("%s  Input:\t%s\n", indent, txdata.DataToString(client, frame.Input))
+		}
+	}
+	if frame.Error != "" {
+		reason := frame.RevertReason
+		if reason == "" && len(frame.Output) > 0 {
+			reason = txdata.RevertToString(client, nil, frame.Output)
+		}
+		if reason == "" {
+			fmt.Printf("%s  Error:\t%s\n", indent, frame.Error)
+		} else {
+			fmt.Printf("%s  Error:\t%s: %s\n", indent, frame.Error, reason)
+		}
+	} else if len(frame.Output) > 0 {
+		if strings.HasPrefix(frame.Type, "CREATE") {
+			if verbose {
+				fmt.Printf("%s  Code:\t\t%#x\n", indent, []byte(frame.Output))
+			}
+		} else {
+			// Return data has no selector, and its types are not known.
+			fmt.Printf("%s  Output:\t%#x\n", indent, []byte(frame.Output))
+		}
+	}
+	for _, log := range frame.Logs {
+		decoded := ""
+		if len(log.Topics) > 0 {
+			decoded = txdata.EventToString(client, log.Log())
+		}
+		if decoded == "" {
+			decoded = fmt.Sprintf("%v", log.Topics)
+		}
+		fmt.Printf("%s  Event:\t%s\n", indent, decoded)
+	}
+	for _, child := range frame.Calls {
+		printCallFrame(child, depth+1)
+	}
+}
+
+func init() {
+	transactionCmd.AddCommand(transactionTraceCmd)
+	transactionFlags(transactionTraceCmd)
+	transactionTraceCmd.Flags().StringVar(&transactionTraceSignatures, "signatures", "", "Semicolon-separated list of custom transaction signatures (e.g. myFunc(address,bytes32);myFunc2(bool)")
+}
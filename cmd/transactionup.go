@@ -14,18 +14,15 @@
 package cmd
 
 import (
-	"bytes"
 	"encoding/hex"
 	"fmt"
-	"math/big"
 	"os"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 	"github.com/wealdtech/ethereal/cli"
-	string2eth "github.com/wealdtech/go-string2eth"
 )
 
 // transactionUpCmd represents the transaction up command
@@ -36,7 +33,7 @@ var transactionUpCmd = &cobra.Command{
 
     ethereal transaction up --gasprice=20gwei --passphrase=secret --transaction=0x454d2274155cce506359de6358785ce5366f6c13e825263674c272eec8532c0c
 
-If no gas price is supplied then it will default to just over 10% higher than the current gas price for the transaction.
+If no gas price is supplied then it will default to just over 10% higher than the current gas price for the transaction.  For transactions with dynamic fees the max fee per gas and max priority fee per gas are increased in the same way, and can be set explicitly with --maxfeepergas and --maxpriorityfeepergas.
 
 This will return an exit status of 0 if the transaction is successfully submitted (and mined if --wait is supplied), 1 if the transaction is not successfully submitted, and 2 if the transaction is successfully submitted but not mined within the supplied time limit.`,
 	Run: func(cmd *cobra.Command, args []string) {
@@ -48,28 +45,23 @@ This will return an exit status of 0 if the transaction is successfully submitte
 		cli.ErrCheck(err, quiet, fmt.Sprintf("Failed to obtain transaction %s", txHash.Hex()))
 		cli.Assert(pending, quiet, fmt.Sprintf("Transaction %s has already been mined", txHash.Hex()))
 
-		minGasPrice := new(big.Int).Add(new(big.Int).Add(tx.GasPrice(), new(big.Int).Div(tx.GasPrice(), big.NewInt(10))), big.NewInt(1))
-		if viper.GetString("gasprice") == "" {
-			// No gas price supplied; use the calculated minimum
-			gasPrice = minGasPrice
-		} else {
-			// Gas price supplied; ensure it is over 10% more than the current gas price
-			cli.Assert(gasPrice.Cmp(minGasPrice) > 0, quiet, fmt.Sprintf("Gas price must be at least %s", string2eth.WeiToString(minGasPrice, true)))
-		}
+		err = setReplacementFees(tx)
+		cli.ErrCheck(err, quiet, "Failed to set fees for replacement transaction")
 
 		// Create and sign the transaction
 		fromAddress, err := txFrom(tx)
 		cli.ErrCheck(err, quiet, "Failed to obtain from address")
 
 		nonce = int64(tx.Nonce())
+		accessList = tx.AccessList()
 		signedTx, err := createSignedTransaction(fromAddress, tx.To(), tx.Value(), tx.Gas(), tx.Data())
 		cli.ErrCheck(err, quiet, "Failed to create transaction")
 
 		if offline {
 			if !quiet {
-				buf := new(bytes.Buffer)
-				signedTx.EncodeRLP(buf)
-				fmt.Printf("0x%s\n", hex.EncodeToString(buf.Bytes()))
+				data, err := signedTx.MarshalBinary()
+				cli.ErrCheck(err, quiet, "Failed to marshal transaction")
+				fmt.Printf("0x%s\n", hex.EncodeToString(data))
 			}
 			os.Exit(_exit_success)
 		}
@@ -78,11 +70,18 @@ This will return an exit status of 0 if the transaction is successfully submitte
 		defer cancel()
 		err = client.SendTransaction(ctx, signedTx)
 		cli.ErrCheck(err, quiet, "Failed to send transaction")
-		handleSubmittedTransaction(signedTx, log.Fields{
-			"group":       "transaction",
-			"command":     "up",
-			"oldgasprice": tx.GasPrice().String(),
-		}, true)
+		logFields := log.Fields{
+			"group":            "transaction",
+			"command":          "up",
+			"oldtransactionid": txHash.Hex(),
+		}
+		if tx.Type() == types.DynamicFeeTxType {
+			logFields["oldmaxfeepergas"] = tx.GasFeeCap().String()
+			logFields["oldmaxpriorityfeepergas"] = tx.GasTipCap().String()
+		} else {
+			logFields["oldgasprice"] = tx.GasPrice().String()
+		}
+		handleSubmittedTransaction(signedTx, logFields, true)
 	},
 }
 
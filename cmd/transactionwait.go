@@ -18,12 +18,14 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/spf13/cobra"
 	"github.com/wealdtech/ethereal/cli"
 	"github.com/wealdtech/ethereal/util"
 )
 
 var transactionWaitLimit time.Duration
+var transactionWaitConfirmations uint64
 
 // transactionWaitCmd represents the transaction info command
 var transactionWaitCmd = &cobra.Command{
@@ -31,16 +33,27 @@ var transactionWaitCmd = &cobra.Command{
 	Short: "Wait for a transaction to be mined",
 	Long: `Wait for a transaction to be mined.  For example:
 
-    ethereal transaction wait --transaction=0x5FfC014343cd971B7eb70732021E26C35B744cc4 --limit=30s
+    ethereal transaction wait --transaction=0x5FfC014343cd971B7eb70732021E26C35B744cc4 --limit=30s --confirmations=3
 
-In quiet mode this will return 0 if the transaction is mined before the time limit is reached, otherwise 1.`,
+The transaction is considered mined once it has been included in --confirmations blocks.  If a chain reorganisation moves or removes the transaction then the confirmations are counted again.
+
+In quiet mode this will return 0 if the transaction is mined and succeeds before the time limit is reached, otherwise 1.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		cli.Assert(transactionStr != "", quiet, "--transaction is required")
 		txHash := common.HexToHash(transactionStr)
 
-		mined := util.WaitForTransaction(client, txHash, transactionWaitLimit)
+		cli.Assert(transactionWaitConfirmations > 0, quiet, "--confirmations must be at least 1")
+		receipt, mined := util.WaitForTransaction(client, txHash, transactionWaitConfirmations, transactionWaitLimit)
 		if mined {
-			outputIf(!quiet, "Transaction mined")
+			if receipt.Status == types.ReceiptStatusFailed {
+				outputIf(!quiet, "Transaction mined but failed")
+			} else {
+				outputIf(!quiet, "Transaction mined")
+			}
+			outputReceipt(receipt)
+			if receipt.Status == types.ReceiptStatusFailed {
+				os.Exit(_exit_failure)
+			}
 			os.Exit(_exit_success)
 		} else {
 			outputIf(!quiet, "Transaction not mined")
@@ -53,4 +66,5 @@ func init() {
 	transactionCmd.AddCommand(transactionWaitCmd)
 	transactionFlags(transactionWaitCmd)
 	transactionWaitCmd.Flags().DurationVar(&transactionWaitLimit, "limit", 0, "maximum time to wait before failing (default forever)")
+	transactionWaitCmd.Flags().Uint64Var(&transactionWaitConfirmations, "confirmations", 1, "number of blocks in which the transaction must be included before it is considered mined")
 }
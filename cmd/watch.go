@@ -0,0 +1,120 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/wealdtech/ethereal/cli"
+	"github.com/wealdtech/ethereal/util"
+)
+
+// watchPollInterval is the interval at which the node is polled for new blocks
+// if it does not support subscriptions.
+const watchPollInterval = 5 * time.Second
+
+var watchExec string
+var watchCheckpoint string
+var watchConfirmations uint64
+var watchFromBlock string
+
+// watchContext returns a context that is cancelled when the process is
+// interrupted or terminated.
+func watchContext() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sigCh
+		outputIf(verbose, "Stopping")
+		cancel()
+	}()
+	return ctx
+}
+
+// watchStart returns the checkpoint from which to start watching.  This is
+// the checkpoint file if present, otherwise --fromblock, otherwise the block
+// after the current head.
+func watchStart() *util.Checkpoint {
+	if watchCheckpoint != "" {
+		checkpoint, err := util.LoadCheckpoint(watchCheckpoint)
+		cli.ErrCheck(err, quiet, "Failed to load checkpoint")
+		if checkpoint != nil {
+			outputIf(verbose, fmt.Sprintf("Resuming from block %d log %d", checkpoint.Block, checkpoint.LogIndex))
+			return checkpoint
+		}
+	}
+
+	if watchFromBlock != "" {
+		block, err := strconv.ParseUint(watchFromBlock, 10, 64)
+		cli.ErrCheck(err, quiet, fmt.Sprintf("Invalid from block %s", watchFromBlock))
+		return &util.Checkpoint{Block: block}
+	}
+
+	ctx, cancel := localContext()
+	defer cancel()
+	head, err := client.BlockNumber(ctx)
+	cli.ErrCheck(err, quiet, "Failed to obtain latest block")
+	return &util.Checkpoint{Block: head + 1}
+}
+
+// watchSaveCheckpoint saves the checkpoint if the user has asked for one.
+func watchSaveCheckpoint(checkpoint *util.Checkpoint) error {
+	if watchCheckpoint == "" {
+		return nil
+	}
+	if err := util.SaveCheckpoint(watchCheckpoint, checkpoint); err != nil {
+		return fmt.Errorf("failed to save checkpoint: %v", err)
+	}
+	return nil
+}
+
+// watchRunHook runs the user's command for an event, supplying the event as
+// JSON on its standard input.
+func watchRunHook(ctx context.Context, env []string, result interface{}) error {
+	input, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	return util.RunHook(ctx, watchExec, env, input, os.Stdout, os.Stderr)
+}
+
+// watchRun calls the handler for each range of blocks as they are confirmed,
+// exiting when the watch is interrupted.
+func watchRun(ctx context.Context, start uint64, handler func(from uint64, to uint64) error) {
+	outputIf(verbose, fmt.Sprintf("Watching from block %d", start))
+	err := util.WatchBlocks(ctx, client, start, watchConfirmations, watchPollInterval, viper.GetDuration("timeout"), handler)
+	if ctx.Err() != nil {
+		// Interrupted by the user.
+		os.Exit(_exit_success)
+	}
+	cli.ErrCheck(err, quiet, "Watch failed")
+}
+
+// Add flags for commands that watch the chain
+func addWatchFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&watchExec, "exec", "", "Command to run for each event, in place of printing it")
+	cmd.Flags().StringVar(&watchCheckpoint, "checkpoint", "", "File in which to record progress, used to resume the watch")
+	cmd.Flags().Uint64Var(&watchConfirmations, "confirmations", 1, "Number of confirmations a block requires before it is processed")
+	cmd.Flags().StringVar(&watchFromBlock, "fromblock", "", "Block from which to start watching (default the next block)")
+}
@@ -0,0 +1,47 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ParseAccessList parses an EIP-2930 access list.  The input can either be
+// the JSON access list itself or the path to a file containing it, for
+// example:
+//
+//     [{"address":"0x...","storageKeys":["0x...","0x..."]}]
+func ParseAccessList(input string) (types.AccessList, error) {
+	var data []byte
+	if strings.HasPrefix(strings.TrimSpace(input), "[") {
+		data = []byte(input)
+	} else {
+		var err error
+		data, err = ioutil.ReadFile(input)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var accessList types.AccessList
+	if err := json.Unmarshal(data, &accessList); err != nil {
+		return nil, fmt.Errorf("invalid access list: %v", err)
+	}
+	return accessList, nil
+}
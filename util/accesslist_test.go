@@ -0,0 +1,88 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAccessList(t *testing.T) {
+	tests := []struct {
+		input  string
+		output types.AccessList
+		err    bool
+	}{
+		{ // 0 - empty
+			input:  `[]`,
+			output: types.AccessList{},
+		},
+		{ // 1 - address only
+			input: `[{"address":"0x5FfC014343cd971B7eb70732021E26C35B744cc4","storageKeys":[]}]`,
+			output: types.AccessList{
+				{Address: common.HexToAddress("0x5FfC014343cd971B7eb70732021E26C35B744cc4"), StorageKeys: []common.Hash{}},
+			},
+		},
+		{ // 2 - address and storage keys
+			input: `[{"address":"0x5FfC014343cd971B7eb70732021E26C35B744cc4","storageKeys":["0x0000000000000000000000000000000000000000000000000000000000000001"]}]`,
+			output: types.AccessList{
+				{
+					Address:     common.HexToAddress("0x5FfC014343cd971B7eb70732021E26C35B744cc4"),
+					StorageKeys: []common.Hash{common.HexToHash("0x01")},
+				},
+			},
+		},
+		{ // 3 - invalid JSON
+			input: `[{"address":`,
+			err:   true,
+		},
+		{ // 4 - missing file
+			input: `/nonexistent/accesslist.json`,
+			err:   true,
+		},
+	}
+
+	for i, tt := range tests {
+		output, err := ParseAccessList(tt.input)
+		if tt.err {
+			assert.NotNil(t, err, fmt.Sprintf("missing expected error at test %d", i))
+		} else {
+			require.Nil(t, err, fmt.Sprintf("unexpected error at test %d", i))
+			assert.Equal(t, tt.output, output, fmt.Sprintf("incorrect output at test %d", i))
+		}
+	}
+}
+
+func TestParseAccessListFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "accesslist")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "accesslist.json")
+	err = ioutil.WriteFile(path, []byte(`[{"address":"0x5FfC014343cd971B7eb70732021E26C35B744cc4","storageKeys":[]}]`), 0600)
+	require.Nil(t, err)
+
+	output, err := ParseAccessList(path)
+	require.Nil(t, err)
+	require.Len(t, output, 1)
+	assert.Equal(t, common.HexToAddress("0x5FfC014343cd971B7eb70732021E26C35B744cc4"), output[0].Address)
+}
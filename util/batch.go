@@ -0,0 +1,232 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Status values for the results of batch transfers.
+const (
+	BatchStatusSigned    = "signed"
+	BatchStatusSubmitted = "submitted"
+	BatchStatusMined     = "mined"
+	BatchStatusFailed    = "failed"
+	BatchStatusNotMined  = "not mined"
+	BatchStatusError     = "error"
+)
+
+// batchResultsHeader is the header line of a batch results file.
+var batchResultsHeader = []string{"line", "to", "amount", "data", "hash", "nonce", "status", "error"}
+
+// BatchTransfer is a single transfer in a batch.
+type BatchTransfer struct {
+	// Line is the line of the batch file on which the transfer appears.
+	Line   int
+	To     string
+	Amount string
+	// Data is either hex data starting with 0x or a free-form memo.
+	Data string
+}
+
+// BatchResult is the result of a single transfer in a batch.
+type BatchResult struct {
+	Line   int
+	To     string
+	Amount string
+	Data   string
+	Hash   string
+	// Nonce is the nonce of the transaction, if it has been signed.
+	Nonce  *uint64
+	Status string
+	Error  string
+}
+
+// Submitted returns true if the transfer has been submitted to the network,
+// in which case it should not be sent again.
+func (r *BatchResult) Submitted() bool {
+	return r.Hash != "" && r.Status != BatchStatusError && r.Status != BatchStatusSigned
+}
+
+// Unconfirmed returns true if a transaction for the transfer has been signed
+// but it is not known if it reached the network, in which case the network
+// must be checked before it is sent again.
+func (r *BatchResult) Unconfirmed() bool {
+	return r.Hash != "" && !r.Submitted()
+}
+
+// Matches returns true if the result is for the given transfer.
+func (r *BatchResult) Matches(transfer *BatchTransfer) bool {
+	return r.Line == transfer.Line && r.To == transfer.To && r.Amount == transfer.Amount && r.Data == transfer.Data
+}
+
+// ParseBatchTransfers parses a batch file.  The file is CSV with one transfer
+// per line, each with a recipient, amount and optional data or memo, for
+// example:
+//
+//	to,amount,data
+//	0x52f1A3027d3aA514F17E454C93ae1F79b3B12d5d,1.5 ether
+//	alice.eth,0.1 ether,payroll March
+//
+// The header line is optional.  Blank lines and lines starting with # are
+// ignored.
+func ParseBatchTransfers(path string) ([]*BatchTransfer, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	transfers := make([]*BatchTransfer, 0)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid batch file: %v", err)
+		}
+		line, _ := reader.FieldPos(0)
+		if len(transfers) == 0 && strings.EqualFold(strings.TrimSpace(record[0]), "to") {
+			// Header
+			continue
+		}
+		if len(record) < 2 || len(record) > 3 {
+			return nil, fmt.Errorf("line %d: expected to, amount and optional data", line)
+		}
+		transfer := &BatchTransfer{
+			Line:   line,
+			To:     strings.TrimSpace(record[0]),
+			Amount: strings.TrimSpace(record[1]),
+		}
+		if len(record) == 3 {
+			transfer.Data = strings.TrimSpace(record[2])
+		}
+		if transfer.To == "" {
+			return nil, fmt.Errorf("line %d: recipient missing", line)
+		}
+		if transfer.Amount == "" {
+			return nil, fmt.Errorf("line %d: amount missing", line)
+		}
+		transfers = append(transfers, transfer)
+	}
+	if len(transfers) == 0 {
+		return nil, fmt.Errorf("no transfers in batch file %s", path)
+	}
+	return transfers, nil
+}
+
+// ReadBatchResults reads a batch results file, returning the results keyed by
+// line of the batch file.  If the file does not exist no results are returned.
+func ReadBatchResults(path string) (map[int]*BatchResult, error) {
+	results := make(map[int]*BatchResult)
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return results, nil
+		}
+		return nil, err
+	}
+	records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("invalid batch results file: %v", err)
+	}
+	for i, record := range records {
+		if i == 0 && len(record) > 0 && record[0] == batchResultsHeader[0] {
+			continue
+		}
+		if len(record) != len(batchResultsHeader) {
+			return nil, fmt.Errorf("invalid batch results file: line %d has %d fields", i+1, len(record))
+		}
+		line, err := strconv.Atoi(record[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid batch results file: line %d: invalid line number", i+1)
+		}
+		result := &BatchResult{
+			Line:   line,
+			To:     record[1],
+			Amount: record[2],
+			Data:   record[3],
+			Hash:   record[4],
+			Status: record[6],
+			Error:  record[7],
+		}
+		if record[5] != "" {
+			nonce, err := strconv.ParseUint(record[5], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid batch results file: line %d: invalid nonce", i+1)
+			}
+			result.Nonce = &nonce
+		}
+		results[line] = result
+	}
+	return results, nil
+}
+
+// WriteBatchResults writes a batch results file, ordered by line of the batch
+// file.  The file is replaced atomically so that an interrupted batch leaves a
+// usable results file.
+func WriteBatchResults(path string, results map[int]*BatchResult) error {
+	lines := make([]int, 0, len(results))
+	for line := range results {
+		lines = append(lines, line)
+	}
+	sort.Ints(lines)
+
+	buf := new(bytes.Buffer)
+	writer := csv.NewWriter(buf)
+	if err := writer.Write(batchResultsHeader); err != nil {
+		return err
+	}
+	for _, line := range lines {
+		result := results[line]
+		nonce := ""
+		if result.Nonce != nil {
+			nonce = strconv.FormatUint(*result.Nonce, 10)
+		}
+		if err := writer.Write([]string{
+			strconv.Itoa(result.Line),
+			result.To,
+			result.Amount,
+			result.Data,
+			result.Hash,
+			nonce,
+			result.Status,
+			result.Error,
+		}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return err
+	}
+
+	tmpPath := path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, buf.Bytes(), 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
@@ -0,0 +1,125 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBatchTransfers(t *testing.T) {
+	tests := []struct {
+		input  string
+		output []*BatchTransfer
+		err    bool
+	}{
+		{ // 0 - single transfer
+			input: "0x52f1A3027d3aA514F17E454C93ae1F79b3B12d5d,1 ether\n",
+			output: []*BatchTransfer{
+				{Line: 1, To: "0x52f1A3027d3aA514F17E454C93ae1F79b3B12d5d", Amount: "1 ether"},
+			},
+		},
+		{ // 1 - header, comments and data
+			input: "to,amount,data\n# Payroll\nalice.eth, 0.1 ether, payroll March\n\nbob.eth,2ether,0x01\n",
+			output: []*BatchTransfer{
+				{Line: 3, To: "alice.eth", Amount: "0.1 ether", Data: "payroll March"},
+				{Line: 5, To: "bob.eth", Amount: "2ether", Data: "0x01"},
+			},
+		},
+		{ // 2 - quoted memo
+			input: "alice.eth,1,\"memo, with comma\"\n",
+			output: []*BatchTransfer{
+				{Line: 1, To: "alice.eth", Amount: "1", Data: "memo, with comma"},
+			},
+		},
+		{ // 3 - missing amount
+			input: "alice.eth\n",
+			err:   true,
+		},
+		{ // 4 - empty amount
+			input: "alice.eth,\n",
+			err:   true,
+		},
+		{ // 5 - too many fields
+			input: "alice.eth,1,memo,extra\n",
+			err:   true,
+		},
+		{ // 6 - no transfers
+			input: "to,amount\n",
+			err:   true,
+		},
+	}
+
+	dir, err := ioutil.TempDir("", "batch")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	for i, tt := range tests {
+		path := filepath.Join(dir, fmt.Sprintf("batch%d.csv", i))
+		require.Nil(t, ioutil.WriteFile(path, []byte(tt.input), 0600))
+		output, err := ParseBatchTransfers(path)
+		if tt.err {
+			assert.NotNil(t, err, fmt.Sprintf("missing expected error at test %d", i))
+		} else {
+			require.Nil(t, err, fmt.Sprintf("unexpected error at test %d", i))
+			assert.Equal(t, tt.output, output, fmt.Sprintf("incorrect output at test %d", i))
+		}
+	}
+}
+
+func TestBatchResults(t *testing.T) {
+	dir, err := ioutil.TempDir("", "batch")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "batch.csv.results")
+
+	// Missing file
+	results, err := ReadBatchResults(path)
+	require.Nil(t, err)
+	assert.Len(t, results, 0)
+
+	results[5] = &BatchResult{Line: 5, To: "bob.eth", Amount: "2ether", Data: "0x01", Status: BatchStatusError, Error: "insufficient funds, for gas"}
+	results[3] = &BatchResult{Line: 3, To: "alice.eth", Amount: "0.1 ether", Data: "payroll", Hash: "0x5f0c6fcc5f0ad6bb9a5bcab4d0bbbbfa3d50d80ac2f0b8d7a6ff6e2f9bcb5d46", Status: BatchStatusMined}
+	nonce := uint64(12)
+	results[7] = &BatchResult{Line: 7, To: "carol.eth", Amount: "1 ether", Hash: "0x8a1c6ee2a0b4ff8aee0fbd4dbb4d0d5c4f4e8e4f09c0b3c2a1e2b3c4d5e6f708", Nonce: &nonce, Status: BatchStatusSigned}
+	require.Nil(t, WriteBatchResults(path, results))
+
+	read, err := ReadBatchResults(path)
+	require.Nil(t, err)
+	assert.Equal(t, results, read)
+	assert.True(t, read[3].Submitted())
+	assert.False(t, read[3].Unconfirmed())
+	assert.False(t, read[5].Submitted())
+	assert.False(t, read[5].Unconfirmed())
+	assert.False(t, read[7].Submitted())
+	assert.True(t, read[7].Unconfirmed())
+	assert.True(t, read[3].Matches(&BatchTransfer{Line: 3, To: "alice.eth", Amount: "0.1 ether", Data: "payroll"}))
+	assert.False(t, read[3].Matches(&BatchTransfer{Line: 3, To: "alice.eth", Amount: "0.2 ether", Data: "payroll"}))
+
+	// File without nonces
+	require.Nil(t, ioutil.WriteFile(path, []byte("line,to,amount,data,hash,status,error\n3,alice.eth,0.1 ether,payroll,0x5f0c6fcc5f0ad6bb9a5bcab4d0bbbbfa3d50d80ac2f0b8d7a6ff6e2f9bcb5d46,mined,\n"), 0600))
+	_, err = ReadBatchResults(path)
+	assert.EqualError(t, err, "invalid batch results file: line 2 has 7 fields")
+
+	// Corrupt file
+	require.Nil(t, ioutil.WriteFile(path, []byte("line,to\n1,alice.eth\n"), 0600))
+	_, err = ReadBatchResults(path)
+	assert.NotNil(t, err)
+}
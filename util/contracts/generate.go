@@ -1,3 +1,12 @@
 package contracts
 
 //go:generate abigen -abi ERC20.abi -out ERC20.go -pkg contracts -type ERC20
+//go:generate abigen -abi ERC1820Registry.abi -out ERC1820Registry.go -pkg contracts -type ERC1820Registry
+//go:generate abigen -abi ERC1820Implementer.abi -out ERC1820Implementer.go -pkg contracts -type ERC1820Implementer
+//go:generate abigen -abi Multicall3.abi -out Multicall3.go -pkg contracts -type Multicall3
+//go:generate abigen -abi ERC1271.abi -out ERC1271.go -pkg contracts -type ERC1271
+//go:generate abigen -abi GnosisSafe.abi -out GnosisSafe.go -pkg contracts -type GnosisSafe
+//go:generate abigen -abi MultiSend.abi -out MultiSend.go -pkg contracts -type MultiSend
+//go:generate abigen -abi ERC165.abi -out ERC165.go -pkg contracts -type ERC165
+//go:generate abigen -abi ERC721.abi -out ERC721.go -pkg contracts -type ERC721
+//go:generate abigen -abi ERC1155.abi -out ERC1155.go -pkg contracts -type ERC1155
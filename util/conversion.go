@@ -15,6 +15,7 @@
 package util
 
 import (
+	"fmt"
 	"math/big"
 	"regexp"
 	"strings"
@@ -71,6 +72,9 @@ func StringToTokenValue(input string, decimals uint8) (output *big.Int, err erro
 	if len(parts) == 2 {
 		// There is a decimal place
 		additionalZeros = int(decimals) - len(parts[1])
+		if additionalZeros < 0 {
+			return nil, fmt.Errorf("%s has more than %d decimal places", input, decimals)
+		}
 	} else {
 		// There is not a decimal place
 		additionalZeros = int(decimals)
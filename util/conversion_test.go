@@ -88,3 +88,8 @@ func TestStringToTokenValue(t *testing.T) {
 		}
 	}
 }
+
+func TestStringToTokenValueTooManyDecimals(t *testing.T) {
+	_, err := StringToTokenValue("1.234", 2)
+	assert.NotNil(t, err, "Did not receive expected error")
+}
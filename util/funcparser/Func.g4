@@ -19,10 +19,11 @@ arg
    | boolArg
    | domainArg
    | arrayArg
+   | tupleArg
    ;
 
 intArg
-   : '-'? INT
+   : MINUS? (INT | DECIMAL) unit?
    ;
 
 hexArg
@@ -46,6 +47,28 @@ arrayArg
    : '[' funcArgs ']'
    ;
 
+tupleArg
+   : '(' tupleFields ')'
+   | '{' tupleFields '}'
+   ;
+
+tupleFields
+   : (tupleField (',' tupleField)*)?
+   ;
+
+tupleField
+   : (fieldName ':')? arg
+   ;
+
+fieldName
+   : NAME
+   ;
+
+unit
+   : NAME
+   | DOMAIN
+   ;
+
 NAME
    : NAMESTART NAMEPART*
    ;
@@ -69,7 +92,7 @@ BOOL
    ;
 
 DOMAIN
-   : '@' ~[,)]+
+   : '@' ~[,)\]} \t\r\n]+
    ;
 
 fragment
@@ -127,3 +150,11 @@ LETTER
 WS
    : [ \r\n\t\u000C]+ -> skip
    ;
+
+DECIMAL
+   : DIGIT+ ('.' DIGIT+)? ([eE] '-'? DIGIT+)?
+   ;
+
+MINUS
+   : '-'
+   ;
@@ -2,6 +2,19 @@ pragma solidity ^0.5.0;
 pragma experimental ABIEncoderV2;
 
 contract Tester {
+    struct Item {
+        uint256 id;
+        address owner;
+        bytes32 tag;
+    }
+
+    struct Group {
+        string name;
+        Item item;
+        Item[] items;
+        uint8[] flags;
+    }
+
     function test() public pure {}
 
     function testUint8(uint8 arg1) public pure returns (uint8) {
@@ -195,4 +208,20 @@ contract Tester {
     function testBytes2DArray(bytes[][] memory arg1) public pure returns (bytes[][] memory) {
         return arg1;
     }
+
+    function testTuple(Item memory arg1) public pure returns (Item memory) {
+        return arg1;
+    }
+
+    function testTupleArray(Item[] memory arg1) public pure returns (Item[] memory) {
+        return arg1;
+    }
+
+    function testNestedTuple(Group memory arg1) public pure returns (Group memory) {
+        return arg1;
+    }
+
+    function testNestedTupleArray(Group[] memory arg1) public pure returns (Group[] memory) {
+        return arg1;
+    }
 }
@@ -16,13 +16,17 @@ package funcparser
 import (
 	"fmt"
 	"math/big"
+	"reflect"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/wealdtech/ethereal/util"
+	"github.com/wealdtech/ethereal/util/contracts"
 	"github.com/wealdtech/ethereal/util/funcparser/parser"
-	ens "github.com/wealdtech/go-ens/v2"
+	ens "github.com/wealdtech/go-ens/v3"
+	string2eth "github.com/wealdtech/go-string2eth"
 )
 
 type methodListener struct {
@@ -33,12 +37,25 @@ type methodListener struct {
 	// Arrays are all of the same type but can be nested.
 	curArray      []interface{}
 	maxArrayLevel int
+	// Tuples, and arrays inside or of tuples, can be of mixed types so are
+	// built up with reflection.
+	frames []*valueFrame
 	// Result of parsing the argument
 	method *abi.Method
 	args   []interface{}
 	err    error
 }
 
+// valueFrame is a tuple or array value under construction.
+type valueFrame struct {
+	typ   *abi.Type
+	value reflect.Value
+	// Tuple fields
+	field int
+	next  int
+	set   []bool
+}
+
 // newMethodListener creates a new method listener
 func newMethodListener(client *ethclient.Client, contract *util.Contract) *methodListener {
 	return &methodListener{
@@ -65,15 +82,20 @@ func (l *methodListener) EnterFuncName(c *parser.FuncNameContext) {
 
 func (l *methodListener) EnterIntArg(c *parser.IntArgContext) {
 	if l.err == nil {
-		input := l.method.Inputs[l.curArg]
 		var err error
 		var arg interface{}
-		baseType := baseType(&input.Type)
+		baseType := l.argType()
 		switch baseType.T {
-		case abi.IntTy:
-			arg, err = StrToInt(baseType, c.GetText())
-		case abi.UintTy:
-			arg, err = StrToUint(baseType, c.GetText())
+		case abi.IntTy, abi.UintTy:
+			var value string
+			value, err = l.intArgValue(c)
+			if err == nil {
+				if baseType.T == abi.IntTy {
+					arg, err = StrToInt(baseType, value)
+				} else {
+					arg, err = StrToUint(baseType, value)
+				}
+			}
 		case abi.AddressTy:
 			err = fmt.Errorf("address \"%s\" looks like number; prefix it with \"0x\"", c.GetText())
 		default:
@@ -87,10 +109,71 @@ func (l *methodListener) EnterIntArg(c *parser.IntArgContext) {
 	}
 }
 
+// intArgValue obtains the value of an integer argument, applying its unit if
+// present.  The unit can be an Ether unit such as 'ether' or 'gwei', or a
+// token such as '@usdc' in which case the token's decimals are used.
+func (l *methodListener) intArgValue(c *parser.IntArgContext) (string, error) {
+	number := c.GetText()
+	if c.INT() != nil {
+		number = c.INT().GetText()
+	} else if c.DECIMAL() != nil {
+		number = c.DECIMAL().GetText()
+	}
+	sign := ""
+	if c.MINUS() != nil {
+		sign = "-"
+	}
+	if c.Unit() == nil {
+		return sign + number, nil
+	}
+
+	expanded, err := expandExponent(number)
+	if err != nil {
+		return "", fmt.Errorf("invalid number %s: %v", number, err)
+	}
+	unit := c.Unit().GetText()
+	var value *big.Int
+	if strings.HasPrefix(unit, "@") {
+		value, err = l.tokenValue(expanded, unit[1:])
+	} else {
+		value, err = string2eth.StringToWei(fmt.Sprintf("%s %s", expanded, unit))
+		if err != nil {
+			err = fmt.Errorf("invalid value %s %s: %v", number, unit, err)
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+	return sign + value.String(), nil
+}
+
+// tokenValue obtains the value of a number of tokens given the token's decimals.
+func (l *methodListener) tokenValue(input string, token string) (*big.Int, error) {
+	if l.client == nil {
+		return nil, fmt.Errorf("cannot obtain decimals for token %s when offline", token)
+	}
+	address, err := util.TokenAddress(l.client, token)
+	if err != nil {
+		return nil, err
+	}
+	contract, err := contracts.NewERC20(address, l.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to obtain token %s: %v", token, err)
+	}
+	decimals, err := contract.Decimals(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to obtain decimals for token %s: %v", token, err)
+	}
+	value, err := util.StringToTokenValue(input, decimals)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for token %s: %v", token, err)
+	}
+	return value, nil
+}
+
 func (l *methodListener) EnterBoolArg(c *parser.BoolArgContext) {
 	if l.err == nil {
-		input := l.method.Inputs[l.curArg]
-		baseType := baseType(&input.Type)
+		baseType := l.argType()
 		arg, err := StrToBool(baseType, c.GetText())
 		if err != nil {
 			l.err = err
@@ -102,8 +185,7 @@ func (l *methodListener) EnterBoolArg(c *parser.BoolArgContext) {
 
 func (l *methodListener) EnterStringArg(c *parser.StringArgContext) {
 	if l.err == nil {
-		input := l.method.Inputs[l.curArg]
-		baseType := baseType(&input.Type)
+		baseType := l.argType()
 		arg, err := StrToStr(baseType, c.GetText())
 		if err != nil {
 			l.err = err
@@ -120,6 +202,10 @@ func (l *methodListener) EnterArrayArg(c *parser.ArrayArgContext) {
 			return
 		}
 		input := l.method.Inputs[l.curArg]
+		if len(l.frames) > 0 || (len(l.curArray) == 0 && baseType(&input.Type).T == abi.TupleTy) {
+			l.enterArrayFrame(c)
+			return
+		}
 		baseType := baseType(&input.Type)
 		level := arrayLevel(&input.Type)
 		if len(l.curArray) == 0 {
@@ -143,6 +229,10 @@ func (l *methodListener) EnterArrayArg(c *parser.ArrayArgContext) {
 
 func (l *methodListener) ExitArrayArg(c *parser.ArrayArgContext) {
 	if l.err == nil {
+		if len(l.frames) > 0 {
+			l.exitArrayFrame(c)
+			return
+		}
 		level := len(l.curArray)
 		if level == 1 {
 			// Only array; push to args
@@ -244,10 +334,9 @@ func (l *methodListener) ExitArrayArg(c *parser.ArrayArgContext) {
 
 func (l *methodListener) EnterDomainArg(c *parser.DomainArgContext) {
 	if l.err == nil {
-		input := l.method.Inputs[l.curArg]
 		var err error
 		var arg interface{}
-		baseType := baseType(&input.Type)
+		baseType := l.argType()
 		switch baseType.T {
 		case abi.AddressTy:
 			arg, err = ens.Resolve(l.client, c.GetText()[1:])
@@ -264,10 +353,9 @@ func (l *methodListener) EnterDomainArg(c *parser.DomainArgContext) {
 
 func (l *methodListener) EnterHexArg(c *parser.HexArgContext) {
 	if l.err == nil {
-		input := l.method.Inputs[l.curArg]
 		var err error
 		var arg interface{}
-		baseType := baseType(&input.Type)
+		baseType := l.argType()
 		switch baseType.T {
 		case abi.AddressTy:
 			arg, err = StrToAddress(baseType, c.GetText())
@@ -296,13 +384,142 @@ func (l *methodListener) EnterArg(c *parser.ArgContext) {
 
 func (l *methodListener) ExitArg(c *parser.ArgContext) {
 	if l.err == nil {
-		// We only increment the argument if we aren't in an array
-		if len(l.curArray) == 0 {
+		// We only increment the argument if we aren't in an array or tuple
+		if len(l.curArray) == 0 && len(l.frames) == 0 {
 			l.curArg++
 		}
 	}
 }
 
+func (l *methodListener) EnterTupleArg(c *parser.TupleArgContext) {
+	if l.err == nil {
+		tupleType := l.valueType()
+		if tupleType.T != abi.TupleTy {
+			l.err = fmt.Errorf("unexpected tuple %s for type %v", c.GetText(), tupleType)
+			return
+		}
+		l.frames = append(l.frames, &valueFrame{
+			typ:   tupleType,
+			value: reflect.New(tupleType.GetType()).Elem(),
+			set:   make([]bool, len(tupleType.TupleElems)),
+		})
+	}
+}
+
+func (l *methodListener) EnterTupleField(c *parser.TupleFieldContext) {
+	if l.err == nil {
+		frame := l.frames[len(l.frames)-1]
+		if c.FieldName() != nil {
+			name := c.FieldName().GetText()
+			frame.field = -1
+			for i := range frame.typ.TupleRawNames {
+				if frame.typ.TupleRawNames[i] == name {
+					frame.field = i
+					break
+				}
+			}
+			if frame.field == -1 {
+				l.err = fmt.Errorf("unknown field %s for type %v", name, frame.typ)
+				return
+			}
+		} else {
+			frame.field = frame.next
+			if frame.field >= len(frame.typ.TupleElems) {
+				l.err = fmt.Errorf("too many fields for type %v", frame.typ)
+				return
+			}
+		}
+		if frame.set[frame.field] {
+			l.err = fmt.Errorf("field %s supplied more than once for type %v", frame.typ.TupleRawNames[frame.field], frame.typ)
+		}
+	}
+}
+
+func (l *methodListener) ExitTupleArg(c *parser.TupleArgContext) {
+	if l.err == nil {
+		frame := l.frames[len(l.frames)-1]
+		for i := range frame.set {
+			if !frame.set[i] {
+				l.err = fmt.Errorf("missing field %s for type %v", frame.typ.TupleRawNames[i], frame.typ)
+				return
+			}
+		}
+		l.frames = l.frames[:len(l.frames)-1]
+		l.pushArg(frame.value.Interface())
+	}
+}
+
+// enterArrayFrame starts an array that is built up with reflection.
+func (l *methodListener) enterArrayFrame(c *parser.ArrayArgContext) {
+	arrayType := l.valueType()
+	if arrayType.T != abi.SliceTy && arrayType.T != abi.ArrayTy {
+		l.err = fmt.Errorf("unexpected array %s for type %v", c.GetText(), arrayType)
+		return
+	}
+	l.frames = append(l.frames, &valueFrame{
+		typ:   arrayType,
+		value: reflect.MakeSlice(reflect.SliceOf(arrayType.Elem.GetType()), 0, 0),
+	})
+}
+
+// exitArrayFrame completes an array that is built up with reflection.
+func (l *methodListener) exitArrayFrame(c *parser.ArrayArgContext) {
+	frame := l.frames[len(l.frames)-1]
+	value := frame.value
+	if frame.typ.T == abi.ArrayTy {
+		if value.Len() != frame.typ.Size {
+			l.err = fmt.Errorf("array %s has %d elements; expected %d", c.GetText(), value.Len(), frame.typ.Size)
+			return
+		}
+		value = reflect.New(frame.typ.GetType()).Elem()
+		reflect.Copy(value, frame.value)
+	}
+	l.frames = l.frames[:len(l.frames)-1]
+	l.pushArg(value.Interface())
+}
+
+// pushFrameValue adds a value to the tuple or array under construction.
+func (l *methodListener) pushFrameValue(arg interface{}) {
+	frame := l.frames[len(l.frames)-1]
+	value := reflect.ValueOf(arg)
+	if frame.typ.T == abi.TupleTy {
+		field := frame.value.Field(frame.field)
+		if !value.Type().AssignableTo(field.Type()) {
+			l.err = fmt.Errorf("invalid value for field %s of type %v", frame.typ.TupleRawNames[frame.field], frame.typ)
+			return
+		}
+		field.Set(value)
+		frame.set[frame.field] = true
+		frame.next = frame.field + 1
+		return
+	}
+	if !value.Type().AssignableTo(frame.value.Type().Elem()) {
+		l.err = fmt.Errorf("invalid value for element of type %v", frame.typ)
+		return
+	}
+	frame.value = reflect.Append(frame.value, value)
+}
+
+// valueType returns the type expected for the current value.
+func (l *methodListener) valueType() *abi.Type {
+	if len(l.frames) == 0 {
+		return &l.method.Inputs[l.curArg].Type
+	}
+	frame := l.frames[len(l.frames)-1]
+	if frame.typ.T == abi.TupleTy {
+		return frame.typ.TupleElems[frame.field]
+	}
+	return frame.typ.Elem
+}
+
+// argType returns the type expected for the current simple value.
+func (l *methodListener) argType() *abi.Type {
+	if len(l.frames) == 0 {
+		return baseType(&l.method.Inputs[l.curArg].Type)
+	}
+	return l.valueType()
+}
+
 func baseType(inputType *abi.Type) *abi.Type {
 	switch inputType.T {
 	case abi.SliceTy:
@@ -325,6 +542,10 @@ func arrayLevel(inputType *abi.Type) int {
 }
 
 func (l *methodListener) pushArg(arg interface{}) {
+	if len(l.frames) > 0 {
+		l.pushFrameValue(arg)
+		return
+	}
 	if len(l.curArray) == 0 {
 		l.args = append(l.args, arg)
 	} else {
@@ -16,9 +16,12 @@ package funcparser
 import (
 	"encoding/hex"
 	"fmt"
+	"io/ioutil"
 	"math/big"
+	"reflect"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -167,6 +170,204 @@ func TestParse(t *testing.T) {
 	}
 }
 
+func TestParseTuples(t *testing.T) {
+	owner1 := common.HexToAddress("0x008b7768c04a0c750C3D6b58d44Ff5041DD90480")
+	owner2 := common.HexToAddress("0x008B7768C04a0C750C3d6B58D44fF5041dd90481")
+	tag := common.HexToHash("0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20")
+	tests := []struct {
+		input  string
+		output interface{}
+		err    string
+	}{
+		{ // 0 - tuple
+			input:  `testTuple((1, 0x008b7768c04a0c750C3D6b58d44Ff5041DD90480, 0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20))`,
+			output: &TesterItem{Id: big.NewInt(1), Owner: owner1, Tag: tag},
+		},
+		{ // 1 - tuple with named fields
+			input:  `testTuple({owner: 0x008b7768c04a0c750C3D6b58d44Ff5041DD90480, tag: 0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20, id: 1})`,
+			output: &TesterItem{Id: big.NewInt(1), Owner: owner1, Tag: tag},
+		},
+		{ // 2 - array of tuples
+			input: `testTupleArray([(1, 0x008b7768c04a0c750C3D6b58d44Ff5041DD90480, 0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20), {id: 2, owner: 0x008B7768C04a0C750C3d6B58D44fF5041dd90481, tag: 0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20}])`,
+			output: &[]TesterItem{
+				{Id: big.NewInt(1), Owner: owner1, Tag: tag},
+				{Id: big.NewInt(2), Owner: owner2, Tag: tag},
+			},
+		},
+		{ // 3 - nested tuples
+			input: `testNestedTuple(("foo", (1, 0x008b7768c04a0c750C3D6b58d44Ff5041DD90480, 0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20), [(2, 0x008B7768C04a0C750C3d6B58D44fF5041dd90481, 0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20)], [1, 2]))`,
+			output: &TesterGroup{
+				Name:  "foo",
+				Item:  TesterItem{Id: big.NewInt(1), Owner: owner1, Tag: tag},
+				Items: []TesterItem{{Id: big.NewInt(2), Owner: owner2, Tag: tag}},
+				Flags: []uint8{1, 2},
+			},
+		},
+		{ // 4 - nested tuples inside an array
+			input: `testNestedTupleArray([{name: "foo", item: (1, 0x008b7768c04a0c750C3D6b58d44Ff5041DD90480, 0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20), items: [], flags: []}, {name: "bar", item: {id: 2, owner: 0x008B7768C04a0C750C3d6B58D44fF5041dd90481, tag: 0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20}, items: [(3, 0x008b7768c04a0c750C3D6b58d44Ff5041DD90480, 0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20), (4, 0x008B7768C04a0C750C3d6B58D44fF5041dd90481, 0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20)], flags: [3]}])`,
+			output: &[]TesterGroup{
+				{
+					Name:  "foo",
+					Item:  TesterItem{Id: big.NewInt(1), Owner: owner1, Tag: tag},
+					Items: []TesterItem{},
+					Flags: []uint8{},
+				},
+				{
+					Name: "bar",
+					Item: TesterItem{Id: big.NewInt(2), Owner: owner2, Tag: tag},
+					Items: []TesterItem{
+						{Id: big.NewInt(3), Owner: owner1, Tag: tag},
+						{Id: big.NewInt(4), Owner: owner2, Tag: tag},
+					},
+					Flags: []uint8{3},
+				},
+			},
+		},
+		{ // 5 - missing field
+			input: `testTuple((1, 0x008b7768c04a0c750C3D6b58d44Ff5041DD90480))`,
+			err:   "missing field tag for type (uint256,address,bytes32)",
+		},
+		{ // 6 - too many fields
+			input: `testTuple((1, 0x008b7768c04a0c750C3D6b58d44Ff5041DD90480, 0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20, 2))`,
+			err:   "too many fields for type (uint256,address,bytes32)",
+		},
+		{ // 7 - unknown field
+			input: `testTuple({id: 1, owner: 0x008b7768c04a0c750C3D6b58d44Ff5041DD90480, label: 0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20})`,
+			err:   "unknown field label for type (uint256,address,bytes32)",
+		},
+		{ // 8 - duplicate field
+			input: `testTuple({id: 1, id: 2})`,
+			err:   "field id supplied more than once for type (uint256,address,bytes32)",
+		},
+		{ // 9 - tuple field of incorrect type
+			input: `testTuple(("foo", 0x008b7768c04a0c750C3D6b58d44Ff5041DD90480, 0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20))`,
+			err:   "invalid value for field id of type (uint256,address,bytes32)",
+		},
+		{ // 10 - tuple for non-tuple
+			input: `testUint256((1))`,
+			err:   "unexpected tuple (1) for type uint256",
+		},
+	}
+
+	json, err := ioutil.ReadFile("Tester.json")
+	require.Nil(t, err, "failed to read Tester ABI")
+	contract, err := util.ParseCombinedJSON(string(json), "Tester")
+	require.Nil(t, err, "failed to parse contract JSON")
+
+	for i, test := range tests {
+		method, args, err := ParseCall(nil, contract, test.input)
+		if test.err != "" {
+			require.NotNil(t, err, fmt.Sprintf("missing expected error at test %d", i))
+			assert.Equal(t, test.err, err.Error(), fmt.Sprintf("incorrect error at test %d", i))
+			continue
+		}
+		require.Nil(t, err, fmt.Sprintf("failed to parse call at test %d", i))
+
+		// Ensure that the arguments pack and unpack to the expected value
+		data, err := contract.Abi.Pack(method.Name, args...)
+		require.Nil(t, err, fmt.Sprintf("failed to pack data at test %d", i))
+		values, err := method.Inputs.Unpack(data[4:])
+		require.Nil(t, err, fmt.Sprintf("failed to unpack data at test %d", i))
+		require.Len(t, values, 1, fmt.Sprintf("incorrect number of values at test %d", i))
+		output := abi.ConvertType(values[0], reflect.New(reflect.TypeOf(test.output).Elem()).Interface())
+		assert.Equal(t, test.output, output, fmt.Sprintf("incorrect value at test %d", i))
+	}
+}
+
+func TestParseNumbers(t *testing.T) {
+	tests := []struct {
+		input  string
+		output interface{}
+		err    string
+	}{
+		{ // 0 - ether
+			input:  `testUint256(1.5 ether)`,
+			output: big.NewInt(1500000000000000000),
+		},
+		{ // 1 - gwei
+			input:  `testUint256(20 gwei)`,
+			output: big.NewInt(20000000000),
+		},
+		{ // 2 - unit without space
+			input:  `testUint64(20gwei)`,
+			output: uint64(20000000000),
+		},
+		{ // 3 - scientific notation
+			input:  `testUint256(1e18)`,
+			output: big.NewInt(1000000000000000000),
+		},
+		{ // 4 - scientific notation with decimal
+			input:  `testUint16(1.5e3)`,
+			output: uint16(1500),
+		},
+		{ // 5 - scientific notation with unit
+			input:  `testUint256(2.5e-3 ether)`,
+			output: big.NewInt(2500000000000000),
+		},
+		{ // 6 - negative
+			input:  `testInt8(-128)`,
+			output: int8(-128),
+		},
+		{ // 7 - negative with unit
+			input:  `testInt256(-1 gwei)`,
+			output: big.NewInt(-1000000000),
+		},
+		{ // 8 - array with units
+			input:  `testUint256Array([1 ether, 1e9, 2])`,
+			output: []*big.Int{big.NewInt(1000000000000000000), big.NewInt(1000000000), big.NewInt(2)},
+		},
+		{ // 9 - uint overflow
+			input: `testUint8(256)`,
+			err:   "value 256 overflows uint8",
+		},
+		{ // 10 - int overflow
+			input: `testInt8(128)`,
+			err:   "value 128 overflows int8",
+		},
+		{ // 11 - int underflow
+			input: `testInt8(-129)`,
+			err:   "value -129 overflows int8",
+		},
+		{ // 12 - overflow with unit
+			input: `testUint64(19 ether)`,
+			err:   "value 19000000000000000000 overflows uint64",
+		},
+		{ // 13 - overflow with scientific notation
+			input: `testUint256(1e78)`,
+			err:   "value 1e78 overflows uint256",
+		},
+		{ // 14 - not a whole number
+			input: `testUint256(1.5)`,
+			err:   "invalid unsigned integer 1.5: not a whole number",
+		},
+		{ // 15 - negative unsigned
+			input: `testUint256(-1)`,
+			err:   "invalid unsigned integer -1",
+		},
+		{ // 16 - token when offline
+			input: `testUint256(100 @usdc)`,
+			err:   "cannot obtain decimals for token usdc when offline",
+		},
+	}
+
+	json, err := ioutil.ReadFile("Tester.json")
+	require.Nil(t, err, "failed to read Tester ABI")
+	contract, err := util.ParseCombinedJSON(string(json), "Tester")
+	require.Nil(t, err, "failed to parse contract JSON")
+
+	for i, test := range tests {
+		_, args, err := ParseCall(nil, contract, test.input)
+		if test.err != "" {
+			require.NotNil(t, err, fmt.Sprintf("missing expected error at test %d", i))
+			assert.Equal(t, test.err, err.Error(), fmt.Sprintf("incorrect error at test %d", i))
+			continue
+		}
+		require.Nil(t, err, fmt.Sprintf("failed to parse call at test %d", i))
+		require.Len(t, args, 1, fmt.Sprintf("incorrect number of arguments at test %d", i))
+		assert.Equal(t, test.output, args[0], fmt.Sprintf("incorrect value at test %d", i))
+	}
+}
+
 func _bytes(input string) []byte {
 	bytes, _ := hex.DecodeString(input)
 	return bytes
@@ -80,3 +80,33 @@ func (s *BaseFuncListener) EnterArrayArg(ctx *ArrayArgContext) {}
 
 // ExitArrayArg is called when production arrayArg is exited.
 func (s *BaseFuncListener) ExitArrayArg(ctx *ArrayArgContext) {}
+
+// EnterTupleArg is called when production tupleArg is entered.
+func (s *BaseFuncListener) EnterTupleArg(ctx *TupleArgContext) {}
+
+// ExitTupleArg is called when production tupleArg is exited.
+func (s *BaseFuncListener) ExitTupleArg(ctx *TupleArgContext) {}
+
+// EnterTupleFields is called when production tupleFields is entered.
+func (s *BaseFuncListener) EnterTupleFields(ctx *TupleFieldsContext) {}
+
+// ExitTupleFields is called when production tupleFields is exited.
+func (s *BaseFuncListener) ExitTupleFields(ctx *TupleFieldsContext) {}
+
+// EnterTupleField is called when production tupleField is entered.
+func (s *BaseFuncListener) EnterTupleField(ctx *TupleFieldContext) {}
+
+// ExitTupleField is called when production tupleField is exited.
+func (s *BaseFuncListener) ExitTupleField(ctx *TupleFieldContext) {}
+
+// EnterFieldName is called when production fieldName is entered.
+func (s *BaseFuncListener) EnterFieldName(ctx *FieldNameContext) {}
+
+// ExitFieldName is called when production fieldName is exited.
+func (s *BaseFuncListener) ExitFieldName(ctx *FieldNameContext) {}
+
+// EnterUnit is called when production unit is entered.
+func (s *BaseFuncListener) EnterUnit(ctx *UnitContext) {}
+
+// ExitUnit is called when production unit is exited.
+func (s *BaseFuncListener) ExitUnit(ctx *UnitContext) {}
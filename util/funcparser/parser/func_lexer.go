@@ -14,89 +14,111 @@ var _ = fmt.Printf
 var _ = unicode.IsLetter
 
 var serializedLexerAtn = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 17, 182,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 21, 220,
 	8, 1, 4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7,
 	9, 7, 4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12,
-	4, 13, 9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4,
-	18, 9, 18, 4, 19, 9, 19, 4, 20, 9, 20, 4, 21, 9, 21, 4, 22, 9, 22, 4, 23,
-	9, 23, 4, 24, 9, 24, 4, 25, 9, 25, 4, 26, 9, 26, 3, 2, 3, 2, 3, 3, 3, 3,
-	3, 4, 3, 4, 3, 5, 3, 5, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 7, 3, 7, 3, 7,
-	3, 7, 3, 7, 3, 7, 3, 8, 3, 8, 3, 9, 3, 9, 3, 10, 3, 10, 7, 10, 79, 10,
-	10, 12, 10, 14, 10, 82, 11, 10, 3, 11, 6, 11, 85, 10, 11, 13, 11, 14, 11,
-	86, 3, 12, 3, 12, 3, 12, 3, 12, 6, 12, 93, 10, 12, 13, 12, 14, 12, 94,
-	3, 13, 3, 13, 7, 13, 99, 10, 13, 12, 13, 14, 13, 102, 11, 13, 3, 13, 3,
-	13, 3, 13, 7, 13, 107, 10, 13, 12, 13, 14, 13, 110, 11, 13, 3, 13, 5, 13,
-	113, 10, 13, 3, 14, 3, 14, 5, 14, 117, 10, 14, 3, 15, 3, 15, 6, 15, 121,
-	10, 15, 13, 15, 14, 15, 122, 3, 16, 3, 16, 3, 17, 3, 17, 3, 17, 3, 17,
-	3, 17, 3, 17, 3, 17, 3, 17, 5, 17, 135, 10, 17, 3, 18, 3, 18, 3, 18, 3,
-	18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 5, 18, 147, 10, 18, 3, 19,
-	3, 19, 3, 19, 5, 19, 152, 10, 19, 3, 20, 3, 20, 3, 20, 5, 20, 157, 10,
-	20, 3, 21, 3, 21, 5, 21, 161, 10, 21, 3, 22, 3, 22, 3, 22, 5, 22, 166,
-	10, 22, 3, 23, 3, 23, 3, 24, 3, 24, 5, 24, 172, 10, 24, 3, 25, 3, 25, 3,
-	26, 6, 26, 177, 10, 26, 13, 26, 14, 26, 178, 3, 26, 3, 26, 2, 2, 27, 3,
-	3, 5, 4, 7, 5, 9, 6, 11, 7, 13, 8, 15, 9, 17, 10, 19, 11, 21, 12, 23, 13,
-	25, 14, 27, 15, 29, 16, 31, 2, 33, 2, 35, 2, 37, 2, 39, 2, 41, 2, 43, 2,
-	45, 2, 47, 2, 49, 2, 51, 17, 3, 2, 10, 4, 2, 43, 43, 46, 46, 5, 2, 50,
-	59, 67, 92, 99, 124, 6, 2, 12, 12, 15, 15, 36, 36, 94, 94, 6, 2, 12, 12,
-	15, 15, 41, 41, 94, 94, 4, 2, 38, 38, 97, 97, 4, 2, 67, 72, 99, 104, 4,
-	2, 67, 92, 99, 124, 5, 2, 11, 12, 14, 15, 34, 34, 2, 188, 2, 3, 3, 2, 2,
-	2, 2, 5, 3, 2, 2, 2, 2, 7, 3, 2, 2, 2, 2, 9, 3, 2, 2, 2, 2, 11, 3, 2, 2,
-	2, 2, 13, 3, 2, 2, 2, 2, 15, 3, 2, 2, 2, 2, 17, 3, 2, 2, 2, 2, 19, 3, 2,
-	2, 2, 2, 21, 3, 2, 2, 2, 2, 23, 3, 2, 2, 2, 2, 25, 3, 2, 2, 2, 2, 27, 3,
-	2, 2, 2, 2, 29, 3, 2, 2, 2, 2, 51, 3, 2, 2, 2, 3, 53, 3, 2, 2, 2, 5, 55,
-	3, 2, 2, 2, 7, 57, 3, 2, 2, 2, 9, 59, 3, 2, 2, 2, 11, 61, 3, 2, 2, 2, 13,
-	66, 3, 2, 2, 2, 15, 72, 3, 2, 2, 2, 17, 74, 3, 2, 2, 2, 19, 76, 3, 2, 2,
-	2, 21, 84, 3, 2, 2, 2, 23, 88, 3, 2, 2, 2, 25, 112, 3, 2, 2, 2, 27, 116,
-	3, 2, 2, 2, 29, 118, 3, 2, 2, 2, 31, 124, 3, 2, 2, 2, 33, 134, 3, 2, 2,
-	2, 35, 146, 3, 2, 2, 2, 37, 151, 3, 2, 2, 2, 39, 156, 3, 2, 2, 2, 41, 160,
-	3, 2, 2, 2, 43, 165, 3, 2, 2, 2, 45, 167, 3, 2, 2, 2, 47, 171, 3, 2, 2,
-	2, 49, 173, 3, 2, 2, 2, 51, 176, 3, 2, 2, 2, 53, 54, 7, 42, 2, 2, 54, 4,
-	3, 2, 2, 2, 55, 56, 7, 43, 2, 2, 56, 6, 3, 2, 2, 2, 57, 58, 7, 46, 2, 2,
-	58, 8, 3, 2, 2, 2, 59, 60, 7, 47, 2, 2, 60, 10, 3, 2, 2, 2, 61, 62, 7,
-	118, 2, 2, 62, 63, 7, 116, 2, 2, 63, 64, 7, 119, 2, 2, 64, 65, 7, 103,
-	2, 2, 65, 12, 3, 2, 2, 2, 66, 67, 7, 104, 2, 2, 67, 68, 7, 99, 2, 2, 68,
-	69, 7, 110, 2, 2, 69, 70, 7, 117, 2, 2, 70, 71, 7, 103, 2, 2, 71, 14, 3,
-	2, 2, 2, 72, 73, 7, 93, 2, 2, 73, 16, 3, 2, 2, 2, 74, 75, 7, 95, 2, 2,
-	75, 18, 3, 2, 2, 2, 76, 80, 5, 41, 21, 2, 77, 79, 5, 43, 22, 2, 78, 77,
-	3, 2, 2, 2, 79, 82, 3, 2, 2, 2, 80, 78, 3, 2, 2, 2, 80, 81, 3, 2, 2, 2,
-	81, 20, 3, 2, 2, 2, 82, 80, 3, 2, 2, 2, 83, 85, 5, 45, 23, 2, 84, 83, 3,
-	2, 2, 2, 85, 86, 3, 2, 2, 2, 86, 84, 3, 2, 2, 2, 86, 87, 3, 2, 2, 2, 87,
-	22, 3, 2, 2, 2, 88, 89, 7, 50, 2, 2, 89, 90, 7, 122, 2, 2, 90, 92, 3, 2,
-	2, 2, 91, 93, 5, 47, 24, 2, 92, 91, 3, 2, 2, 2, 93, 94, 3, 2, 2, 2, 94,
-	92, 3, 2, 2, 2, 94, 95, 3, 2, 2, 2, 95, 24, 3, 2, 2, 2, 96, 100, 7, 36,
-	2, 2, 97, 99, 5, 37, 19, 2, 98, 97, 3, 2, 2, 2, 99, 102, 3, 2, 2, 2, 100,
-	98, 3, 2, 2, 2, 100, 101, 3, 2, 2, 2, 101, 103, 3, 2, 2, 2, 102, 100, 3,
-	2, 2, 2, 103, 113, 7, 36, 2, 2, 104, 108, 7, 41, 2, 2, 105, 107, 5, 39,
-	20, 2, 106, 105, 3, 2, 2, 2, 107, 110, 3, 2, 2, 2, 108, 106, 3, 2, 2, 2,
-	108, 109, 3, 2, 2, 2, 109, 111, 3, 2, 2, 2, 110, 108, 3, 2, 2, 2, 111,
-	113, 7, 41, 2, 2, 112, 96, 3, 2, 2, 2, 112, 104, 3, 2, 2, 2, 113, 26, 3,
-	2, 2, 2, 114, 117, 5, 33, 17, 2, 115, 117, 5, 35, 18, 2, 116, 114, 3, 2,
-	2, 2, 116, 115, 3, 2, 2, 2, 117, 28, 3, 2, 2, 2, 118, 120, 7, 66, 2, 2,
-	119, 121, 10, 2, 2, 2, 120, 119, 3, 2, 2, 2, 121, 122, 3, 2, 2, 2, 122,
-	120, 3, 2, 2, 2, 122, 123, 3, 2, 2, 2, 123, 30, 3, 2, 2, 2, 124, 125, 9,
-	3, 2, 2, 125, 32, 3, 2, 2, 2, 126, 127, 7, 118, 2, 2, 127, 128, 7, 116,
-	2, 2, 128, 129, 7, 119, 2, 2, 129, 135, 7, 103, 2, 2, 130, 131, 7, 86,
-	2, 2, 131, 132, 7, 116, 2, 2, 132, 133, 7, 119, 2, 2, 133, 135, 7, 103,
-	2, 2, 134, 126, 3, 2, 2, 2, 134, 130, 3, 2, 2, 2, 135, 34, 3, 2, 2, 2,
-	136, 137, 7, 104, 2, 2, 137, 138, 7, 99, 2, 2, 138, 139, 7, 110, 2, 2,
-	139, 140, 7, 117, 2, 2, 140, 147, 7, 103, 2, 2, 141, 142, 7, 72, 2, 2,
-	142, 143, 7, 99, 2, 2, 143, 144, 7, 110, 2, 2, 144, 145, 7, 117, 2, 2,
-	145, 147, 7, 103, 2, 2, 146, 136, 3, 2, 2, 2, 146, 141, 3, 2, 2, 2, 147,
-	36, 3, 2, 2, 2, 148, 152, 10, 4, 2, 2, 149, 150, 7, 94, 2, 2, 150, 152,
-	11, 2, 2, 2, 151, 148, 3, 2, 2, 2, 151, 149, 3, 2, 2, 2, 152, 38, 3, 2,
-	2, 2, 153, 157, 10, 5, 2, 2, 154, 155, 7, 94, 2, 2, 155, 157, 11, 2, 2,
-	2, 156, 153, 3, 2, 2, 2, 156, 154, 3, 2, 2, 2, 157, 40, 3, 2, 2, 2, 158,
-	161, 5, 49, 25, 2, 159, 161, 9, 6, 2, 2, 160, 158, 3, 2, 2, 2, 160, 159,
-	3, 2, 2, 2, 161, 42, 3, 2, 2, 2, 162, 166, 5, 49, 25, 2, 163, 166, 9, 6,
-	2, 2, 164, 166, 5, 45, 23, 2, 165, 162, 3, 2, 2, 2, 165, 163, 3, 2, 2,
-	2, 165, 164, 3, 2, 2, 2, 166, 44, 3, 2, 2, 2, 167, 168, 4, 50, 59, 2, 168,
-	46, 3, 2, 2, 2, 169, 172, 5, 45, 23, 2, 170, 172, 9, 7, 2, 2, 171, 169,
-	3, 2, 2, 2, 171, 170, 3, 2, 2, 2, 172, 48, 3, 2, 2, 2, 173, 174, 9, 8,
-	2, 2, 174, 50, 3, 2, 2, 2, 175, 177, 9, 9, 2, 2, 176, 175, 3, 2, 2, 2,
-	177, 178, 3, 2, 2, 2, 178, 176, 3, 2, 2, 2, 178, 179, 3, 2, 2, 2, 179,
-	180, 3, 2, 2, 2, 180, 181, 8, 26, 2, 2, 181, 52, 3, 2, 2, 2, 19, 2, 80,
-	86, 94, 100, 108, 112, 116, 122, 134, 146, 151, 156, 160, 165, 171, 178,
-	3, 8, 2, 2,
+	4, 13, 9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17,
+	4, 18, 9, 18, 4, 19, 9, 19, 4, 20, 9, 20, 4, 21, 9, 21, 4, 22, 9, 22,
+	4, 23, 9, 23, 4, 24, 9, 24, 4, 25, 9, 25, 4, 26, 9, 26, 4, 27, 9, 27,
+	4, 28, 9, 28, 4, 29, 9, 29, 4, 30, 9, 30, 3, 2, 3, 2, 3, 3, 3, 3, 3, 4,
+	3, 4, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6,
+	3, 7, 3, 7, 3, 8, 3, 8, 3, 9, 3, 9, 3, 10, 3, 10, 3, 11, 3, 11, 3, 12,
+	3, 12, 7, 12, 91, 10, 12, 12, 12, 14, 12, 94, 11, 12, 3, 13, 6, 13, 97,
+	10, 13, 13, 13, 14, 13, 98, 3, 14, 3, 14, 3, 14, 3, 14, 6, 14, 105, 10,
+	14, 13, 14, 14, 14, 106, 3, 15, 3, 15, 7, 15, 111, 10, 15, 12, 15, 14,
+	15, 114, 11, 15, 3, 15, 3, 15, 3, 15, 7, 15, 119, 10, 15, 12, 15, 14,
+	15, 122, 11, 15, 3, 15, 5, 15, 125, 10, 15, 3, 16, 3, 16, 5, 16, 129,
+	10, 16, 3, 17, 3, 17, 6, 17, 133, 10, 17, 13, 17, 14, 17, 134, 3, 18,
+	3, 18, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 5, 19,
+	147, 10, 19, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3,
+	20, 3, 20, 5, 20, 159, 10, 20, 3, 21, 3, 21, 3, 21, 5, 21, 164, 10, 21,
+	3, 22, 3, 22, 3, 22, 5, 22, 169, 10, 22, 3, 23, 3, 23, 5, 23, 173, 10,
+	23, 3, 24, 3, 24, 3, 24, 5, 24, 178, 10, 24, 3, 25, 3, 25, 3, 26, 3,
+	26, 5, 26, 184, 10, 26, 3, 27, 3, 27, 3, 28, 6, 28, 189, 10, 28, 13,
+	28, 14, 28, 190, 3, 28, 3, 28, 3, 29, 6, 29, 196, 10, 29, 13, 29, 14,
+	29, 197, 3, 29, 3, 29, 6, 29, 202, 10, 29, 13, 29, 14, 29, 203, 5, 29,
+	206, 10, 29, 3, 29, 3, 29, 5, 29, 210, 10, 29, 3, 29, 6, 29, 213, 10,
+	29, 13, 29, 14, 29, 214, 5, 29, 217, 10, 29, 3, 30, 3, 30, 2, 2, 31, 3,
+	3, 5, 4, 7, 5, 9, 6, 11, 7, 13, 8, 15, 9, 17, 10, 19, 11, 21, 12, 23,
+	13, 25, 14, 27, 15, 29, 16, 31, 17, 33, 18, 35, 2, 37, 2, 39, 2, 41, 2,
+	43, 2, 45, 2, 47, 2, 49, 2, 51, 2, 53, 2, 55, 19, 57, 20, 59, 21, 3, 2,
+	11, 9, 2, 11, 12, 15, 15, 34, 34, 43, 43, 46, 46, 95, 95, 127, 127, 5,
+	2, 50, 59, 67, 92, 99, 124, 6, 2, 12, 12, 15, 15, 36, 36, 94, 94, 6, 2,
+	12, 12, 15, 15, 41, 41, 94, 94, 4, 2, 38, 38, 97, 97, 4, 2, 67, 72, 99,
+	104, 4, 2, 67, 92, 99, 124, 5, 2, 11, 12, 14, 15, 34, 34, 4, 2, 71, 71,
+	103, 103, 2, 232, 2, 3, 3, 2, 2, 2, 2, 5, 3, 2, 2, 2, 2, 7, 3, 2, 2, 2,
+	2, 9, 3, 2, 2, 2, 2, 11, 3, 2, 2, 2, 2, 13, 3, 2, 2, 2, 2, 15, 3, 2, 2,
+	2, 2, 17, 3, 2, 2, 2, 2, 19, 3, 2, 2, 2, 2, 21, 3, 2, 2, 2, 2, 23, 3,
+	2, 2, 2, 2, 25, 3, 2, 2, 2, 2, 27, 3, 2, 2, 2, 2, 29, 3, 2, 2, 2, 2,
+	31, 3, 2, 2, 2, 2, 33, 3, 2, 2, 2, 2, 55, 3, 2, 2, 2, 2, 57, 3, 2, 2,
+	2, 2, 59, 3, 2, 2, 2, 3, 61, 3, 2, 2, 2, 5, 63, 3, 2, 2, 2, 7, 65, 3,
+	2, 2, 2, 9, 67, 3, 2, 2, 2, 11, 72, 3, 2, 2, 2, 13, 78, 3, 2, 2, 2, 15,
+	80, 3, 2, 2, 2, 17, 82, 3, 2, 2, 2, 19, 84, 3, 2, 2, 2, 21, 86, 3, 2,
+	2, 2, 23, 88, 3, 2, 2, 2, 25, 96, 3, 2, 2, 2, 27, 100, 3, 2, 2, 2, 29,
+	124, 3, 2, 2, 2, 31, 128, 3, 2, 2, 2, 33, 130, 3, 2, 2, 2, 35, 136, 3,
+	2, 2, 2, 37, 146, 3, 2, 2, 2, 39, 158, 3, 2, 2, 2, 41, 163, 3, 2, 2, 2,
+	43, 168, 3, 2, 2, 2, 45, 172, 3, 2, 2, 2, 47, 177, 3, 2, 2, 2, 49, 179,
+	3, 2, 2, 2, 51, 183, 3, 2, 2, 2, 53, 185, 3, 2, 2, 2, 55, 188, 3, 2, 2,
+	2, 57, 195, 3, 2, 2, 2, 59, 218, 3, 2, 2, 2, 61, 62, 7, 42, 2, 2, 62,
+	4, 3, 2, 2, 2, 63, 64, 7, 43, 2, 2, 64, 6, 3, 2, 2, 2, 65, 66, 7, 46,
+	2, 2, 66, 8, 3, 2, 2, 2, 67, 68, 7, 118, 2, 2, 68, 69, 7, 116, 2, 2,
+	69, 70, 7, 119, 2, 2, 70, 71, 7, 103, 2, 2, 71, 10, 3, 2, 2, 2, 72, 73,
+	7, 104, 2, 2, 73, 74, 7, 99, 2, 2, 74, 75, 7, 110, 2, 2, 75, 76, 7,
+	117, 2, 2, 76, 77, 7, 103, 2, 2, 77, 12, 3, 2, 2, 2, 78, 79, 7, 93, 2,
+	2, 79, 14, 3, 2, 2, 2, 80, 81, 7, 95, 2, 2, 81, 16, 3, 2, 2, 2, 82, 83,
+	7, 125, 2, 2, 83, 18, 3, 2, 2, 2, 84, 85, 7, 127, 2, 2, 85, 20, 3, 2,
+	2, 2, 86, 87, 7, 60, 2, 2, 87, 22, 3, 2, 2, 2, 88, 92, 5, 45, 23, 2,
+	89, 91, 5, 47, 24, 2, 90, 89, 3, 2, 2, 2, 91, 94, 3, 2, 2, 2, 92, 90,
+	3, 2, 2, 2, 92, 93, 3, 2, 2, 2, 93, 24, 3, 2, 2, 2, 94, 92, 3, 2, 2, 2,
+	95, 97, 5, 49, 25, 2, 96, 95, 3, 2, 2, 2, 97, 98, 3, 2, 2, 2, 98, 96,
+	3, 2, 2, 2, 98, 99, 3, 2, 2, 2, 99, 26, 3, 2, 2, 2, 100, 101, 7, 50, 2,
+	2, 101, 102, 7, 122, 2, 2, 102, 104, 3, 2, 2, 2, 103, 105, 5, 51, 26,
+	2, 104, 103, 3, 2, 2, 2, 105, 106, 3, 2, 2, 2, 106, 104, 3, 2, 2, 2,
+	106, 107, 3, 2, 2, 2, 107, 28, 3, 2, 2, 2, 108, 112, 7, 36, 2, 2, 109,
+	111, 5, 41, 21, 2, 110, 109, 3, 2, 2, 2, 111, 114, 3, 2, 2, 2, 112,
+	110, 3, 2, 2, 2, 112, 113, 3, 2, 2, 2, 113, 115, 3, 2, 2, 2, 114, 112,
+	3, 2, 2, 2, 115, 125, 7, 36, 2, 2, 116, 120, 7, 41, 2, 2, 117, 119, 5,
+	43, 22, 2, 118, 117, 3, 2, 2, 2, 119, 122, 3, 2, 2, 2, 120, 118, 3, 2,
+	2, 2, 120, 121, 3, 2, 2, 2, 121, 123, 3, 2, 2, 2, 122, 120, 3, 2, 2, 2,
+	123, 125, 7, 41, 2, 2, 124, 108, 3, 2, 2, 2, 124, 116, 3, 2, 2, 2, 125,
+	30, 3, 2, 2, 2, 126, 129, 5, 37, 19, 2, 127, 129, 5, 39, 20, 2, 128,
+	126, 3, 2, 2, 2, 128, 127, 3, 2, 2, 2, 129, 32, 3, 2, 2, 2, 130, 132,
+	7, 66, 2, 2, 131, 133, 10, 2, 2, 2, 132, 131, 3, 2, 2, 2, 133, 134, 3,
+	2, 2, 2, 134, 132, 3, 2, 2, 2, 134, 135, 3, 2, 2, 2, 135, 34, 3, 2, 2,
+	2, 136, 137, 9, 3, 2, 2, 137, 36, 3, 2, 2, 2, 138, 139, 7, 118, 2, 2,
+	139, 140, 7, 116, 2, 2, 140, 141, 7, 119, 2, 2, 141, 147, 7, 103, 2, 2,
+	142, 143, 7, 86, 2, 2, 143, 144, 7, 116, 2, 2, 144, 145, 7, 119, 2, 2,
+	145, 147, 7, 103, 2, 2, 146, 138, 3, 2, 2, 2, 146, 142, 3, 2, 2, 2,
+	147, 38, 3, 2, 2, 2, 148, 149, 7, 104, 2, 2, 149, 150, 7, 99, 2, 2,
+	150, 151, 7, 110, 2, 2, 151, 152, 7, 117, 2, 2, 152, 159, 7, 103, 2, 2,
+	153, 154, 7, 72, 2, 2, 154, 155, 7, 99, 2, 2, 155, 156, 7, 110, 2, 2,
+	156, 157, 7, 117, 2, 2, 157, 159, 7, 103, 2, 2, 158, 148, 3, 2, 2, 2,
+	158, 153, 3, 2, 2, 2, 159, 40, 3, 2, 2, 2, 160, 164, 10, 4, 2, 2, 161,
+	162, 7, 94, 2, 2, 162, 164, 11, 2, 2, 2, 163, 160, 3, 2, 2, 2, 163,
+	161, 3, 2, 2, 2, 164, 42, 3, 2, 2, 2, 165, 169, 10, 5, 2, 2, 166, 167,
+	7, 94, 2, 2, 167, 169, 11, 2, 2, 2, 168, 165, 3, 2, 2, 2, 168, 166, 3,
+	2, 2, 2, 169, 44, 3, 2, 2, 2, 170, 173, 5, 53, 27, 2, 171, 173, 9, 6,
+	2, 2, 172, 170, 3, 2, 2, 2, 172, 171, 3, 2, 2, 2, 173, 46, 3, 2, 2, 2,
+	174, 178, 5, 53, 27, 2, 175, 178, 9, 6, 2, 2, 176, 178, 5, 49, 25, 2,
+	177, 174, 3, 2, 2, 2, 177, 175, 3, 2, 2, 2, 177, 176, 3, 2, 2, 2, 178,
+	48, 3, 2, 2, 2, 179, 180, 4, 50, 59, 2, 180, 50, 3, 2, 2, 2, 181, 184,
+	5, 49, 25, 2, 182, 184, 9, 7, 2, 2, 183, 181, 3, 2, 2, 2, 183, 182, 3,
+	2, 2, 2, 184, 52, 3, 2, 2, 2, 185, 186, 9, 8, 2, 2, 186, 54, 3, 2, 2,
+	2, 187, 189, 9, 9, 2, 2, 188, 187, 3, 2, 2, 2, 189, 190, 3, 2, 2, 2,
+	190, 188, 3, 2, 2, 2, 190, 191, 3, 2, 2, 2, 191, 192, 3, 2, 2, 2, 192,
+	193, 8, 28, 2, 2, 193, 56, 3, 2, 2, 2, 194, 196, 5, 49, 25, 2, 195,
+	194, 3, 2, 2, 2, 196, 197, 3, 2, 2, 2, 197, 195, 3, 2, 2, 2, 197, 198,
+	3, 2, 2, 2, 198, 205, 3, 2, 2, 2, 199, 201, 7, 48, 2, 2, 200, 202, 5,
+	49, 25, 2, 201, 200, 3, 2, 2, 2, 202, 203, 3, 2, 2, 2, 203, 201, 3, 2,
+	2, 2, 203, 204, 3, 2, 2, 2, 204, 206, 3, 2, 2, 2, 205, 199, 3, 2, 2, 2,
+	205, 206, 3, 2, 2, 2, 206, 216, 3, 2, 2, 2, 207, 209, 9, 10, 2, 2, 208,
+	210, 7, 47, 2, 2, 209, 208, 3, 2, 2, 2, 209, 210, 3, 2, 2, 2, 210, 212,
+	3, 2, 2, 2, 211, 213, 5, 49, 25, 2, 212, 211, 3, 2, 2, 2, 213, 214, 3,
+	2, 2, 2, 214, 212, 3, 2, 2, 2, 214, 215, 3, 2, 2, 2, 215, 217, 3, 2, 2,
+	2, 216, 207, 3, 2, 2, 2, 216, 217, 3, 2, 2, 2, 217, 58, 3, 2, 2, 2,
+	218, 219, 7, 47, 2, 2, 219, 60, 3, 2, 2, 2, 25, 2, 92, 98, 106, 112,
+	120, 124, 128, 134, 146, 158, 163, 168, 172, 177, 183, 190, 197, 203,
+	205, 209, 214, 216, 3, 8, 2, 2,
 }
 
 var lexerDeserializer = antlr.NewATNDeserializer(nil)
@@ -111,19 +133,20 @@ var lexerModeNames = []string{
 }
 
 var lexerLiteralNames = []string{
-	"", "'('", "')'", "','", "'-'", "'true'", "'false'", "'['", "']'",
+	"", "'('", "')'", "','", "'true'", "'false'", "'['", "']'", "'{'", "'}'",
+	"':'", "", "", "", "", "", "", "", "", "'-'",
 }
 
 var lexerSymbolicNames = []string{
-	"", "", "", "", "", "", "", "", "", "NAME", "INT", "HEX", "STRING", "BOOL",
-	"DOMAIN", "WS",
+	"", "", "", "", "", "", "", "", "", "", "", "NAME", "INT", "HEX", "STRING",
+	"BOOL", "DOMAIN", "WS", "DECIMAL", "MINUS",
 }
 
 var lexerRuleNames = []string{
-	"T__0", "T__1", "T__2", "T__3", "T__4", "T__5", "T__6", "T__7", "NAME",
-	"INT", "HEX", "STRING", "BOOL", "DOMAIN", "ENSCHAR", "TRUE", "FALSE", "DOUBLEQUOTEDCHAR",
-	"SINGLEQUOTEDCHAR", "NAMESTART", "NAMEPART", "DIGIT", "HEXDIGIT", "LETTER",
-	"WS",
+	"T__0", "T__1", "T__2", "T__3", "T__4", "T__5", "T__6", "T__7", "T__8", "T__9",
+	"NAME", "INT", "HEX", "STRING", "BOOL", "DOMAIN", "ENSCHAR", "TRUE", "FALSE",
+	"DOUBLEQUOTEDCHAR", "SINGLEQUOTEDCHAR", "NAMESTART", "NAMEPART", "DIGIT",
+	"HEXDIGIT", "LETTER", "WS", "DECIMAL", "MINUS",
 }
 
 type FuncLexer struct {
@@ -161,19 +184,23 @@ func NewFuncLexer(input antlr.CharStream) *FuncLexer {
 
 // FuncLexer tokens.
 const (
-	FuncLexerT__0   = 1
-	FuncLexerT__1   = 2
-	FuncLexerT__2   = 3
-	FuncLexerT__3   = 4
-	FuncLexerT__4   = 5
-	FuncLexerT__5   = 6
-	FuncLexerT__6   = 7
-	FuncLexerT__7   = 8
-	FuncLexerNAME   = 9
-	FuncLexerINT    = 10
-	FuncLexerHEX    = 11
-	FuncLexerSTRING = 12
-	FuncLexerBOOL   = 13
-	FuncLexerDOMAIN = 14
-	FuncLexerWS     = 15
+	FuncLexerT__0    = 1
+	FuncLexerT__1    = 2
+	FuncLexerT__2    = 3
+	FuncLexerT__3    = 4
+	FuncLexerT__4    = 5
+	FuncLexerT__5    = 6
+	FuncLexerT__6    = 7
+	FuncLexerT__7    = 8
+	FuncLexerT__8    = 9
+	FuncLexerT__9    = 10
+	FuncLexerNAME    = 11
+	FuncLexerINT     = 12
+	FuncLexerHEX     = 13
+	FuncLexerSTRING  = 14
+	FuncLexerBOOL    = 15
+	FuncLexerDOMAIN  = 16
+	FuncLexerWS      = 17
+	FuncLexerDECIMAL = 18
+	FuncLexerMINUS   = 19
 )
@@ -38,6 +38,21 @@ type FuncListener interface {
 	// EnterArrayArg is called when entering the arrayArg production.
 	EnterArrayArg(c *ArrayArgContext)
 
+	// EnterTupleArg is called when entering the tupleArg production.
+	EnterTupleArg(c *TupleArgContext)
+
+	// EnterTupleFields is called when entering the tupleFields production.
+	EnterTupleFields(c *TupleFieldsContext)
+
+	// EnterTupleField is called when entering the tupleField production.
+	EnterTupleField(c *TupleFieldContext)
+
+	// EnterFieldName is called when entering the fieldName production.
+	EnterFieldName(c *FieldNameContext)
+
+	// EnterUnit is called when entering the unit production.
+	EnterUnit(c *UnitContext)
+
 	// ExitStart is called when exiting the start production.
 	ExitStart(c *StartContext)
 
@@ -67,4 +82,19 @@ type FuncListener interface {
 
 	// ExitArrayArg is called when exiting the arrayArg production.
 	ExitArrayArg(c *ArrayArgContext)
+
+	// ExitTupleArg is called when exiting the tupleArg production.
+	ExitTupleArg(c *TupleArgContext)
+
+	// ExitTupleFields is called when exiting the tupleFields production.
+	ExitTupleFields(c *TupleFieldsContext)
+
+	// ExitTupleField is called when exiting the tupleField production.
+	ExitTupleField(c *TupleFieldContext)
+
+	// ExitFieldName is called when exiting the fieldName production.
+	ExitFieldName(c *FieldNameContext)
+
+	// ExitUnit is called when exiting the unit production.
+	ExitUnit(c *UnitContext)
 }
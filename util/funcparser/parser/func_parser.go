@@ -16,47 +16,67 @@ var _ = reflect.Copy
 var _ = strconv.Itoa
 
 var parserATN = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 17, 66, 4,
-	2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7, 9, 7, 4,
-	8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 3, 2, 3, 2, 3, 2, 3, 2,
-	3, 2, 3, 2, 3, 3, 3, 3, 3, 4, 3, 4, 3, 4, 7, 4, 34, 10, 4, 12, 4, 14, 4,
-	37, 11, 4, 5, 4, 39, 10, 4, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 5, 5, 47,
-	10, 5, 3, 6, 5, 6, 50, 10, 6, 3, 6, 3, 6, 3, 7, 3, 7, 3, 8, 3, 8, 3, 9,
-	3, 9, 3, 10, 3, 10, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 2, 2, 12, 2, 4,
-	6, 8, 10, 12, 14, 16, 18, 20, 2, 3, 3, 2, 7, 8, 2, 63, 2, 22, 3, 2, 2,
-	2, 4, 28, 3, 2, 2, 2, 6, 38, 3, 2, 2, 2, 8, 46, 3, 2, 2, 2, 10, 49, 3,
-	2, 2, 2, 12, 53, 3, 2, 2, 2, 14, 55, 3, 2, 2, 2, 16, 57, 3, 2, 2, 2, 18,
-	59, 3, 2, 2, 2, 20, 61, 3, 2, 2, 2, 22, 23, 5, 4, 3, 2, 23, 24, 7, 3, 2,
-	2, 24, 25, 5, 6, 4, 2, 25, 26, 7, 4, 2, 2, 26, 27, 7, 2, 2, 3, 27, 3, 3,
-	2, 2, 2, 28, 29, 7, 11, 2, 2, 29, 5, 3, 2, 2, 2, 30, 35, 5, 8, 5, 2, 31,
-	32, 7, 5, 2, 2, 32, 34, 5, 8, 5, 2, 33, 31, 3, 2, 2, 2, 34, 37, 3, 2, 2,
-	2, 35, 33, 3, 2, 2, 2, 35, 36, 3, 2, 2, 2, 36, 39, 3, 2, 2, 2, 37, 35,
-	3, 2, 2, 2, 38, 30, 3, 2, 2, 2, 38, 39, 3, 2, 2, 2, 39, 7, 3, 2, 2, 2,
-	40, 47, 5, 10, 6, 2, 41, 47, 5, 12, 7, 2, 42, 47, 5, 14, 8, 2, 43, 47,
-	5, 16, 9, 2, 44, 47, 5, 18, 10, 2, 45, 47, 5, 20, 11, 2, 46, 40, 3, 2,
-	2, 2, 46, 41, 3, 2, 2, 2, 46, 42, 3, 2, 2, 2, 46, 43, 3, 2, 2, 2, 46, 44,
-	3, 2, 2, 2, 46, 45, 3, 2, 2, 2, 47, 9, 3, 2, 2, 2, 48, 50, 7, 6, 2, 2,
-	49, 48, 3, 2, 2, 2, 49, 50, 3, 2, 2, 2, 50, 51, 3, 2, 2, 2, 51, 52, 7,
-	12, 2, 2, 52, 11, 3, 2, 2, 2, 53, 54, 7, 13, 2, 2, 54, 13, 3, 2, 2, 2,
-	55, 56, 7, 14, 2, 2, 56, 15, 3, 2, 2, 2, 57, 58, 9, 2, 2, 2, 58, 17, 3,
-	2, 2, 2, 59, 60, 7, 16, 2, 2, 60, 19, 3, 2, 2, 2, 61, 62, 7, 9, 2, 2, 62,
-	63, 5, 6, 4, 2, 63, 64, 7, 10, 2, 2, 64, 21, 3, 2, 2, 2, 6, 35, 38, 46,
-	49,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 21, 107,
+	4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7, 9, 7,
+	4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12, 4,
+	13, 9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 3, 2, 3, 2, 3, 2,
+	3, 2, 3, 2, 3, 2, 3, 3, 3, 3, 3, 4, 3, 4, 3, 4, 7, 4, 44, 10, 4, 12, 4,
+	14, 4, 47, 11, 4, 5, 4, 49, 10, 4, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5,
+	3, 5, 5, 5, 58, 10, 5, 3, 6, 5, 6, 61, 10, 6, 3, 6, 3, 6, 5, 6, 65, 10,
+	6, 3, 7, 3, 7, 3, 8, 3, 8, 3, 9, 3, 9, 3, 10, 3, 10, 3, 11, 3, 11, 3,
+	11, 3, 11, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 5, 12, 85, 10, 12,
+	3, 13, 3, 13, 3, 13, 7, 13, 90, 10, 13, 12, 13, 14, 13, 93, 11, 13, 5,
+	13, 95, 10, 13, 3, 14, 3, 14, 5, 14, 99, 10, 14, 3, 14, 3, 14, 3, 15,
+	3, 15, 3, 16, 3, 16, 3, 16, 2, 2, 17, 2, 4, 6, 8, 10, 12, 14, 16, 18,
+	20, 22, 24, 26, 28, 30, 2, 5, 4, 2, 14, 14, 20, 20, 3, 2, 6, 7, 4, 2,
+	13, 13, 18, 18, 2, 105, 2, 32, 3, 2, 2, 2, 4, 38, 3, 2, 2, 2, 6, 48, 3,
+	2, 2, 2, 8, 57, 3, 2, 2, 2, 10, 60, 3, 2, 2, 2, 12, 66, 3, 2, 2, 2, 14,
+	68, 3, 2, 2, 2, 16, 70, 3, 2, 2, 2, 18, 72, 3, 2, 2, 2, 20, 74, 3, 2,
+	2, 2, 22, 84, 3, 2, 2, 2, 24, 94, 3, 2, 2, 2, 26, 98, 3, 2, 2, 2, 28,
+	102, 3, 2, 2, 2, 30, 104, 3, 2, 2, 2, 32, 33, 5, 4, 3, 2, 33, 34, 7, 3,
+	2, 2, 34, 35, 5, 6, 4, 2, 35, 36, 7, 4, 2, 2, 36, 37, 7, 2, 2, 3, 37,
+	3, 3, 2, 2, 2, 38, 39, 7, 13, 2, 2, 39, 5, 3, 2, 2, 2, 40, 45, 5, 8, 5,
+	2, 41, 42, 7, 5, 2, 2, 42, 44, 5, 8, 5, 2, 43, 41, 3, 2, 2, 2, 44, 47,
+	3, 2, 2, 2, 45, 43, 3, 2, 2, 2, 45, 46, 3, 2, 2, 2, 46, 49, 3, 2, 2, 2,
+	47, 45, 3, 2, 2, 2, 48, 40, 3, 2, 2, 2, 48, 49, 3, 2, 2, 2, 49, 7, 3,
+	2, 2, 2, 50, 58, 5, 10, 6, 2, 51, 58, 5, 12, 7, 2, 52, 58, 5, 14, 8, 2,
+	53, 58, 5, 16, 9, 2, 54, 58, 5, 18, 10, 2, 55, 58, 5, 20, 11, 2, 56,
+	58, 5, 22, 12, 2, 57, 50, 3, 2, 2, 2, 57, 51, 3, 2, 2, 2, 57, 52, 3, 2,
+	2, 2, 57, 53, 3, 2, 2, 2, 57, 54, 3, 2, 2, 2, 57, 55, 3, 2, 2, 2, 57,
+	56, 3, 2, 2, 2, 58, 9, 3, 2, 2, 2, 59, 61, 7, 21, 2, 2, 60, 59, 3, 2,
+	2, 2, 60, 61, 3, 2, 2, 2, 61, 62, 3, 2, 2, 2, 62, 64, 9, 2, 2, 2, 63,
+	65, 5, 30, 16, 2, 64, 63, 3, 2, 2, 2, 64, 65, 3, 2, 2, 2, 65, 11, 3, 2,
+	2, 2, 66, 67, 7, 15, 2, 2, 67, 13, 3, 2, 2, 2, 68, 69, 7, 16, 2, 2, 69,
+	15, 3, 2, 2, 2, 70, 71, 9, 3, 2, 2, 71, 17, 3, 2, 2, 2, 72, 73, 7, 18,
+	2, 2, 73, 19, 3, 2, 2, 2, 74, 75, 7, 8, 2, 2, 75, 76, 5, 6, 4, 2, 76,
+	77, 7, 9, 2, 2, 77, 21, 3, 2, 2, 2, 78, 79, 7, 3, 2, 2, 79, 80, 5, 24,
+	13, 2, 80, 85, 7, 4, 2, 2, 81, 82, 7, 10, 2, 2, 82, 83, 5, 24, 13, 2,
+	83, 85, 7, 11, 2, 2, 84, 78, 3, 2, 2, 2, 84, 81, 3, 2, 2, 2, 85, 23, 3,
+	2, 2, 2, 86, 91, 5, 26, 14, 2, 87, 88, 7, 5, 2, 2, 88, 90, 5, 26, 14,
+	2, 89, 87, 3, 2, 2, 2, 90, 93, 3, 2, 2, 2, 91, 89, 3, 2, 2, 2, 91, 92,
+	3, 2, 2, 2, 92, 95, 3, 2, 2, 2, 93, 91, 3, 2, 2, 2, 94, 86, 3, 2, 2, 2,
+	94, 95, 3, 2, 2, 2, 95, 25, 3, 2, 2, 2, 96, 97, 5, 28, 15, 2, 97, 99,
+	7, 12, 2, 2, 98, 96, 3, 2, 2, 2, 98, 99, 3, 2, 2, 2, 99, 100, 3, 2, 2,
+	2, 100, 101, 5, 8, 5, 2, 101, 27, 3, 2, 2, 2, 102, 103, 7, 13, 2, 2,
+	103, 29, 3, 2, 2, 2, 104, 105, 9, 4, 2, 2, 105, 31, 3, 2, 2, 2, 11, 45,
+	48, 57, 60, 64, 84, 91, 94, 98,
 }
 var deserializer = antlr.NewATNDeserializer(nil)
 var deserializedATN = deserializer.DeserializeFromUInt16(parserATN)
 
 var literalNames = []string{
-	"", "'('", "')'", "','", "'-'", "'true'", "'false'", "'['", "']'",
+	"", "'('", "')'", "','", "'true'", "'false'", "'['", "']'", "'{'", "'}'",
+	"':'", "", "", "", "", "", "", "", "", "'-'",
 }
 var symbolicNames = []string{
-	"", "", "", "", "", "", "", "", "", "NAME", "INT", "HEX", "STRING", "BOOL",
-	"DOMAIN", "WS",
+	"", "", "", "", "", "", "", "", "", "", "", "NAME", "INT", "HEX", "STRING",
+	"BOOL", "DOMAIN", "WS", "DECIMAL", "MINUS",
 }
 
 var ruleNames = []string{
 	"start", "funcName", "funcArgs", "arg", "intArg", "hexArg", "stringArg",
-	"boolArg", "domainArg", "arrayArg",
+	"boolArg", "domainArg", "arrayArg", "tupleArg", "tupleFields", "tupleField",
+	"fieldName", "unit",
 }
 var decisionToDFA = make([]*antlr.DFA, len(deserializedATN.DecisionToState))
 
@@ -86,36 +106,45 @@ func NewFuncParser(input antlr.TokenStream) *FuncParser {
 
 // FuncParser tokens.
 const (
-	FuncParserEOF    = antlr.TokenEOF
-	FuncParserT__0   = 1
-	FuncParserT__1   = 2
-	FuncParserT__2   = 3
-	FuncParserT__3   = 4
-	FuncParserT__4   = 5
-	FuncParserT__5   = 6
-	FuncParserT__6   = 7
-	FuncParserT__7   = 8
-	FuncParserNAME   = 9
-	FuncParserINT    = 10
-	FuncParserHEX    = 11
-	FuncParserSTRING = 12
-	FuncParserBOOL   = 13
-	FuncParserDOMAIN = 14
-	FuncParserWS     = 15
+	FuncParserEOF     = antlr.TokenEOF
+	FuncParserT__0    = 1
+	FuncParserT__1    = 2
+	FuncParserT__2    = 3
+	FuncParserT__3    = 4
+	FuncParserT__4    = 5
+	FuncParserT__5    = 6
+	FuncParserT__6    = 7
+	FuncParserT__7    = 8
+	FuncParserT__8    = 9
+	FuncParserT__9    = 10
+	FuncParserNAME    = 11
+	FuncParserINT     = 12
+	FuncParserHEX     = 13
+	FuncParserSTRING  = 14
+	FuncParserBOOL    = 15
+	FuncParserDOMAIN  = 16
+	FuncParserWS      = 17
+	FuncParserDECIMAL = 18
+	FuncParserMINUS   = 19
 )
 
 // FuncParser rules.
 const (
-	FuncParserRULE_start     = 0
-	FuncParserRULE_funcName  = 1
-	FuncParserRULE_funcArgs  = 2
-	FuncParserRULE_arg       = 3
-	FuncParserRULE_intArg    = 4
-	FuncParserRULE_hexArg    = 5
-	FuncParserRULE_stringArg = 6
-	FuncParserRULE_boolArg   = 7
-	FuncParserRULE_domainArg = 8
-	FuncParserRULE_arrayArg  = 9
+	FuncParserRULE_start       = 0
+	FuncParserRULE_funcName    = 1
+	FuncParserRULE_funcArgs    = 2
+	FuncParserRULE_arg         = 3
+	FuncParserRULE_intArg      = 4
+	FuncParserRULE_hexArg      = 5
+	FuncParserRULE_stringArg   = 6
+	FuncParserRULE_boolArg     = 7
+	FuncParserRULE_domainArg   = 8
+	FuncParserRULE_arrayArg    = 9
+	FuncParserRULE_tupleArg    = 10
+	FuncParserRULE_tupleFields = 11
+	FuncParserRULE_tupleField  = 12
+	FuncParserRULE_fieldName   = 13
+	FuncParserRULE_unit        = 14
 )
 
 // IStartContext is an interface to support dynamic dispatch.
@@ -222,23 +251,23 @@ func (p *FuncParser) Start() (localctx IStartContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(20)
+		p.SetState(30)
 		p.FuncName()
 	}
 	{
-		p.SetState(21)
+		p.SetState(31)
 		p.Match(FuncParserT__0)
 	}
 	{
-		p.SetState(22)
+		p.SetState(32)
 		p.FuncArgs()
 	}
 	{
-		p.SetState(23)
+		p.SetState(33)
 		p.Match(FuncParserT__1)
 	}
 	{
-		p.SetState(24)
+		p.SetState(34)
 		p.Match(FuncParserEOF)
 	}
 
@@ -329,7 +358,7 @@ func (p *FuncParser) FuncName() (localctx IFuncNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(26)
+		p.SetState(36)
 		p.Match(FuncParserNAME)
 	}
 
@@ -439,30 +468,30 @@ func (p *FuncParser) FuncArgs() (localctx IFuncArgsContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(36)
+	p.SetState(46)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if ((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FuncParserT__3)|(1<<FuncParserT__4)|(1<<FuncParserT__5)|(1<<FuncParserT__6)|(1<<FuncParserINT)|(1<<FuncParserHEX)|(1<<FuncParserSTRING)|(1<<FuncParserDOMAIN))) != 0 {
+	if ((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FuncParserT__0)|(1<<FuncParserT__3)|(1<<FuncParserT__4)|(1<<FuncParserT__5)|(1<<FuncParserT__7)|(1<<FuncParserINT)|(1<<FuncParserHEX)|(1<<FuncParserSTRING)|(1<<FuncParserDOMAIN)|(1<<FuncParserDECIMAL)|(1<<FuncParserMINUS))) != 0 {
 		{
-			p.SetState(28)
+			p.SetState(38)
 			p.Arg()
 		}
-		p.SetState(33)
+		p.SetState(43)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == FuncParserT__2 {
 			{
-				p.SetState(29)
+				p.SetState(39)
 				p.Match(FuncParserT__2)
 			}
 			{
-				p.SetState(30)
+				p.SetState(40)
 				p.Arg()
 			}
 
-			p.SetState(35)
+			p.SetState(45)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
@@ -570,6 +599,16 @@ func (s *ArgContext) ArrayArg() IArrayArgContext {
 	return t.(IArrayArgContext)
 }
 
+func (s *ArgContext) TupleArg() ITupleArgContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITupleArgContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITupleArgContext)
+}
+
 func (s *ArgContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -610,52 +649,59 @@ func (p *FuncParser) Arg() (localctx IArgContext) {
 		}
 	}()
 
-	p.SetState(44)
+	p.SetState(55)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case FuncParserT__3, FuncParserINT:
+	case FuncParserINT, FuncParserDECIMAL, FuncParserMINUS:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(38)
+			p.SetState(48)
 			p.IntArg()
 		}
 
 	case FuncParserHEX:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(39)
+			p.SetState(49)
 			p.HexArg()
 		}
 
 	case FuncParserSTRING:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(40)
+			p.SetState(50)
 			p.StringArg()
 		}
 
-	case FuncParserT__4, FuncParserT__5:
+	case FuncParserT__3, FuncParserT__4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(41)
+			p.SetState(51)
 			p.BoolArg()
 		}
 
 	case FuncParserDOMAIN:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(42)
+			p.SetState(52)
 			p.DomainArg()
 		}
 
-	case FuncParserT__6:
+	case FuncParserT__5:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(43)
+			p.SetState(53)
 			p.ArrayArg()
 		}
 
+	case FuncParserT__0, FuncParserT__7:
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(54)
+			p.TupleArg()
+		}
+
 	default:
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
@@ -701,10 +747,28 @@ func NewIntArgContext(parser antlr.Parser, parent antlr.ParserRuleContext, invok
 
 func (s *IntArgContext) GetParser() antlr.Parser { return s.parser }
 
+func (s *IntArgContext) MINUS() antlr.TerminalNode {
+	return s.GetToken(FuncParserMINUS, 0)
+}
+
 func (s *IntArgContext) INT() antlr.TerminalNode {
 	return s.GetToken(FuncParserINT, 0)
 }
 
+func (s *IntArgContext) DECIMAL() antlr.TerminalNode {
+	return s.GetToken(FuncParserDECIMAL, 0)
+}
+
+func (s *IntArgContext) Unit() IUnitContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IUnitContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IUnitContext)
+}
+
 func (s *IntArgContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -747,20 +811,38 @@ func (p *FuncParser) IntArg() (localctx IIntArgContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(47)
+	p.SetState(58)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == FuncParserT__3 {
+	if _la == FuncParserMINUS {
 		{
-			p.SetState(46)
-			p.Match(FuncParserT__3)
+			p.SetState(57)
+			p.Match(FuncParserMINUS)
 		}
 
 	}
 	{
-		p.SetState(49)
-		p.Match(FuncParserINT)
+		p.SetState(60)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == FuncParserINT || _la == FuncParserDECIMAL) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+	p.SetState(62)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == FuncParserNAME || _la == FuncParserDOMAIN {
+		{
+			p.SetState(61)
+			p.Unit()
+		}
+
 	}
 
 	return localctx
@@ -850,7 +932,7 @@ func (p *FuncParser) HexArg() (localctx IHexArgContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(51)
+		p.SetState(64)
 		p.Match(FuncParserHEX)
 	}
 
@@ -941,7 +1023,7 @@ func (p *FuncParser) StringArg() (localctx IStringArgContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(53)
+		p.SetState(66)
 		p.Match(FuncParserSTRING)
 	}
 
@@ -1028,10 +1110,10 @@ func (p *FuncParser) BoolArg() (localctx IBoolArgContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(55)
+		p.SetState(68)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(_la == FuncParserT__4 || _la == FuncParserT__5) {
+		if !(_la == FuncParserT__3 || _la == FuncParserT__4) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -1126,7 +1208,7 @@ func (p *FuncParser) DomainArg() (localctx IDomainArgContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(57)
+		p.SetState(70)
 		p.Match(FuncParserDOMAIN)
 	}
 
@@ -1223,16 +1305,598 @@ func (p *FuncParser) ArrayArg() (localctx IArrayArgContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(59)
-		p.Match(FuncParserT__6)
+		p.SetState(72)
+		p.Match(FuncParserT__5)
 	}
 	{
-		p.SetState(60)
+		p.SetState(73)
 		p.FuncArgs()
 	}
 	{
-		p.SetState(61)
-		p.Match(FuncParserT__7)
+		p.SetState(74)
+		p.Match(FuncParserT__6)
+	}
+
+	return localctx
+}
+
+// ITupleArgContext is an interface to support dynamic dispatch.
+type ITupleArgContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsTupleArgContext differentiates from other interfaces.
+	IsTupleArgContext()
+}
+
+type TupleArgContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTupleArgContext() *TupleArgContext {
+	var p = new(TupleArgContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FuncParserRULE_tupleArg
+	return p
+}
+
+func (*TupleArgContext) IsTupleArgContext() {}
+
+func NewTupleArgContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TupleArgContext {
+	var p = new(TupleArgContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FuncParserRULE_tupleArg
+
+	return p
+}
+
+func (s *TupleArgContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TupleArgContext) TupleFields() ITupleFieldsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITupleFieldsContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITupleFieldsContext)
+}
+
+func (s *TupleArgContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TupleArgContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TupleArgContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FuncListener); ok {
+		listenerT.EnterTupleArg(s)
+	}
+}
+
+func (s *TupleArgContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FuncListener); ok {
+		listenerT.ExitTupleArg(s)
+	}
+}
+
+func (p *FuncParser) TupleArg() (localctx ITupleArgContext) {
+	localctx = NewTupleArgContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 20, FuncParserRULE_tupleArg)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(82)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case FuncParserT__0:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(76)
+			p.Match(FuncParserT__0)
+		}
+		{
+			p.SetState(77)
+			p.TupleFields()
+		}
+		{
+			p.SetState(78)
+			p.Match(FuncParserT__1)
+		}
+
+	case FuncParserT__7:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(79)
+			p.Match(FuncParserT__7)
+		}
+		{
+			p.SetState(80)
+			p.TupleFields()
+		}
+		{
+			p.SetState(81)
+			p.Match(FuncParserT__8)
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// ITupleFieldsContext is an interface to support dynamic dispatch.
+type ITupleFieldsContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsTupleFieldsContext differentiates from other interfaces.
+	IsTupleFieldsContext()
+}
+
+type TupleFieldsContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTupleFieldsContext() *TupleFieldsContext {
+	var p = new(TupleFieldsContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FuncParserRULE_tupleFields
+	return p
+}
+
+func (*TupleFieldsContext) IsTupleFieldsContext() {}
+
+func NewTupleFieldsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TupleFieldsContext {
+	var p = new(TupleFieldsContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FuncParserRULE_tupleFields
+
+	return p
+}
+
+func (s *TupleFieldsContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TupleFieldsContext) AllTupleField() []ITupleFieldContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ITupleFieldContext)(nil)).Elem())
+	var tst = make([]ITupleFieldContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(ITupleFieldContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *TupleFieldsContext) TupleField(i int) ITupleFieldContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITupleFieldContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITupleFieldContext)
+}
+
+func (s *TupleFieldsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TupleFieldsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TupleFieldsContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FuncListener); ok {
+		listenerT.EnterTupleFields(s)
+	}
+}
+
+func (s *TupleFieldsContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FuncListener); ok {
+		listenerT.ExitTupleFields(s)
+	}
+}
+
+func (p *FuncParser) TupleFields() (localctx ITupleFieldsContext) {
+	localctx = NewTupleFieldsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 22, FuncParserRULE_tupleFields)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(92)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if ((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FuncParserT__0)|(1<<FuncParserT__3)|(1<<FuncParserT__4)|(1<<FuncParserT__5)|(1<<FuncParserT__7)|(1<<FuncParserNAME)|(1<<FuncParserINT)|(1<<FuncParserHEX)|(1<<FuncParserSTRING)|(1<<FuncParserDOMAIN)|(1<<FuncParserDECIMAL)|(1<<FuncParserMINUS))) != 0 {
+		{
+			p.SetState(84)
+			p.TupleField()
+		}
+		p.SetState(89)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == FuncParserT__2 {
+			{
+				p.SetState(85)
+				p.Match(FuncParserT__2)
+			}
+			{
+				p.SetState(86)
+				p.TupleField()
+			}
+
+			p.SetState(91)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+
+	}
+
+	return localctx
+}
+
+// ITupleFieldContext is an interface to support dynamic dispatch.
+type ITupleFieldContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsTupleFieldContext differentiates from other interfaces.
+	IsTupleFieldContext()
+}
+
+type TupleFieldContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTupleFieldContext() *TupleFieldContext {
+	var p = new(TupleFieldContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FuncParserRULE_tupleField
+	return p
+}
+
+func (*TupleFieldContext) IsTupleFieldContext() {}
+
+func NewTupleFieldContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TupleFieldContext {
+	var p = new(TupleFieldContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FuncParserRULE_tupleField
+
+	return p
+}
+
+func (s *TupleFieldContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TupleFieldContext) FieldName() IFieldNameContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFieldNameContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldNameContext)
+}
+
+func (s *TupleFieldContext) Arg() IArgContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IArgContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IArgContext)
+}
+
+func (s *TupleFieldContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TupleFieldContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TupleFieldContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FuncListener); ok {
+		listenerT.EnterTupleField(s)
+	}
+}
+
+func (s *TupleFieldContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FuncListener); ok {
+		listenerT.ExitTupleField(s)
+	}
+}
+
+func (p *FuncParser) TupleField() (localctx ITupleFieldContext) {
+	localctx = NewTupleFieldContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 24, FuncParserRULE_tupleField)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(96)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == FuncParserNAME {
+		{
+			p.SetState(94)
+			p.FieldName()
+		}
+		{
+			p.SetState(95)
+			p.Match(FuncParserT__9)
+		}
+
+	}
+	{
+		p.SetState(98)
+		p.Arg()
+	}
+
+	return localctx
+}
+
+// IFieldNameContext is an interface to support dynamic dispatch.
+type IFieldNameContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsFieldNameContext differentiates from other interfaces.
+	IsFieldNameContext()
+}
+
+type FieldNameContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyFieldNameContext() *FieldNameContext {
+	var p = new(FieldNameContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FuncParserRULE_fieldName
+	return p
+}
+
+func (*FieldNameContext) IsFieldNameContext() {}
+
+func NewFieldNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FieldNameContext {
+	var p = new(FieldNameContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FuncParserRULE_fieldName
+
+	return p
+}
+
+func (s *FieldNameContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *FieldNameContext) NAME() antlr.TerminalNode {
+	return s.GetToken(FuncParserNAME, 0)
+}
+
+func (s *FieldNameContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FieldNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *FieldNameContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FuncListener); ok {
+		listenerT.EnterFieldName(s)
+	}
+}
+
+func (s *FieldNameContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FuncListener); ok {
+		listenerT.ExitFieldName(s)
+	}
+}
+
+func (p *FuncParser) FieldName() (localctx IFieldNameContext) {
+	localctx = NewFieldNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 26, FuncParserRULE_fieldName)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(100)
+		p.Match(FuncParserNAME)
+	}
+
+	return localctx
+}
+
+// IUnitContext is an interface to support dynamic dispatch.
+type IUnitContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsUnitContext differentiates from other interfaces.
+	IsUnitContext()
+}
+
+type UnitContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyUnitContext() *UnitContext {
+	var p = new(UnitContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FuncParserRULE_unit
+	return p
+}
+
+func (*UnitContext) IsUnitContext() {}
+
+func NewUnitContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UnitContext {
+	var p = new(UnitContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FuncParserRULE_unit
+
+	return p
+}
+
+func (s *UnitContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *UnitContext) NAME() antlr.TerminalNode {
+	return s.GetToken(FuncParserNAME, 0)
+}
+
+func (s *UnitContext) DOMAIN() antlr.TerminalNode {
+	return s.GetToken(FuncParserDOMAIN, 0)
+}
+
+func (s *UnitContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *UnitContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *UnitContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FuncListener); ok {
+		listenerT.EnterUnit(s)
+	}
+}
+
+func (s *UnitContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FuncListener); ok {
+		listenerT.ExitUnit(s)
+	}
+}
+
+func (p *FuncParser) Unit() (localctx IUnitContext) {
+	localctx = NewUnitContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 28, FuncParserRULE_unit)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(102)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == FuncParserNAME || _la == FuncParserDOMAIN) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
 	}
 
 	return localctx
@@ -15,9 +15,11 @@ package funcparser
 
 import (
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math/big"
 	"reflect"
+	"strconv"
 	"strings"
 	"unsafe"
 
@@ -63,12 +65,18 @@ func StrTo(inputType *abi.Type, input string) (interface{}, error) {
 }
 
 // StrToInt turns a string in to an int type as given by the ABI information.
+// The string can be in scientific notation, for example 1e18, as long as
+// the result is a whole number.
 // It can return various types so return interface{}
 func StrToInt(inputType *abi.Type, input string) (interface{}, error) {
-	val := big.NewInt(0)
-	val, success := val.SetString(input, 10)
-	if !success {
-		return nil, fmt.Errorf("invalid integer %s", input)
+	val, err := strToBigInt(input)
+	if err != nil {
+		return nil, fmt.Errorf("invalid integer %s: %v", input, err)
+	}
+	// Range is -2^(size-1) to 2^(size-1)-1
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(inputType.Size-1))
+	if val.Cmp(limit) >= 0 || val.Cmp(new(big.Int).Neg(limit)) < 0 {
+		return nil, fmt.Errorf("value %s overflows int%d", input, inputType.Size)
 	}
 	switch inputType.Size {
 	case 8:
@@ -78,11 +86,9 @@ func StrToInt(inputType *abi.Type, input string) (interface{}, error) {
 	case 32:
 		return int32(val.Int64()), nil
 	case 64:
-		return int64(val.Int64()), nil
-	case 256:
-		return val, nil
+		return val.Int64(), nil
 	default:
-		return nil, fmt.Errorf("unexpected int size %d", inputType.Size)
+		return val, nil
 	}
 }
 
@@ -90,16 +96,20 @@ func StrToInt(inputType *abi.Type, input string) (interface{}, error) {
 var _zero = big.NewInt(0)
 
 // StrToUint turns a string in to a uint type as given by the ABI information.
+// The string can be in scientific notation, for example 1e18, as long as
+// the result is a whole number.
 // It can return various types so return interface{}
 func StrToUint(inputType *abi.Type, input string) (interface{}, error) {
-	val := big.NewInt(0)
-	val, success := val.SetString(input, 10)
-	if !success {
-		return nil, fmt.Errorf("invalid unsigned integer %s", input)
+	val, err := strToBigInt(input)
+	if err != nil {
+		return nil, fmt.Errorf("invalid unsigned integer %s: %v", input, err)
 	}
 	if val.Cmp(_zero) < 0 {
 		return nil, fmt.Errorf("invalid unsigned integer %s", input)
 	}
+	if val.BitLen() > inputType.Size {
+		return nil, fmt.Errorf("value %s overflows uint%d", input, inputType.Size)
+	}
 	switch inputType.Size {
 	case 8:
 		return uint8(val.Uint64()), nil
@@ -109,11 +119,80 @@ func StrToUint(inputType *abi.Type, input string) (interface{}, error) {
 		return uint32(val.Uint64()), nil
 	case 64:
 		return val.Uint64(), nil
-	case 256:
-		return val, nil
 	default:
-		return nil, fmt.Errorf("unexpected int size %d", inputType.Size)
+		return val, nil
+	}
+}
+
+// _maxExponent is the largest exponent accepted in scientific notation.
+// It is well beyond the range of any integer type.
+const _maxExponent = 1000
+
+// expandExponent turns a number that can be in scientific notation, for
+// example 1.5e3, in to its plain decimal form, for example 1500.
+func expandExponent(input string) (string, error) {
+	mantissa := input
+	exponent := 0
+	if pos := strings.IndexAny(input, "eE"); pos != -1 {
+		var err error
+		exponent, err = strconv.Atoi(input[pos+1:])
+		if err != nil || exponent > _maxExponent || exponent < -_maxExponent {
+			return "", errors.New("invalid exponent")
+		}
+		mantissa = input[:pos]
+	}
+	negative := strings.HasPrefix(mantissa, "-")
+	mantissa = strings.TrimPrefix(mantissa, "-")
+	intPart := mantissa
+	fracPart := ""
+	if pos := strings.Index(mantissa, "."); pos != -1 {
+		intPart = mantissa[:pos]
+		fracPart = mantissa[pos+1:]
+	}
+	digits := intPart + fracPart
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return "", errors.New("invalid number")
+	}
+
+	// Move the decimal point according to the exponent
+	point := len(intPart) + exponent
+	if point <= 0 {
+		digits = strings.Repeat("0", 1-point) + digits
+		point = 1
+	} else if point > len(digits) {
+		digits += strings.Repeat("0", point-len(digits))
+	}
+	intPart = strings.TrimLeft(digits[:point], "0")
+	if intPart == "" {
+		intPart = "0"
+	}
+	fracPart = strings.TrimRight(digits[point:], "0")
+
+	res := intPart
+	if fracPart != "" {
+		res = fmt.Sprintf("%s.%s", res, fracPart)
+	}
+	if negative && res != "0" {
+		res = "-" + res
+	}
+	return res, nil
+}
+
+// strToBigInt turns a string that can be in scientific notation in to a
+// big integer.
+func strToBigInt(input string) (*big.Int, error) {
+	expanded, err := expandExponent(input)
+	if err != nil {
+		return nil, err
+	}
+	if strings.Contains(expanded, ".") {
+		return nil, errors.New("not a whole number")
+	}
+	val, success := new(big.Int).SetString(expanded, 10)
+	if !success {
+		return nil, errors.New("invalid number")
 	}
+	return val, nil
 }
 
 // StrToStr turns a string in to a string type as given by the ABI information.
@@ -0,0 +1,117 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	bip39 "github.com/tyler-smith/go-bip39"
+)
+
+// DefaultHDPath is the default derivation path for Ethereum accounts.
+const DefaultHDPath = "m/44'/60'/0'/0/0"
+
+// MnemonicToSeed creates a BIP-39 seed from a mnemonic and optional
+// passphrase.  If validate is true the mnemonic must be made up of words from
+// the word list and have a valid checksum.
+func MnemonicToSeed(mnemonic string, passphrase string, validate bool) ([]byte, error) {
+	mnemonic = strings.Join(strings.Fields(mnemonic), " ")
+	if mnemonic == "" {
+		return nil, errors.New("no mnemonic supplied")
+	}
+	if validate {
+		if _, err := bip39.EntropyFromMnemonic(mnemonic); err != nil {
+			return nil, fmt.Errorf("invalid mnemonic: %v", err)
+		}
+	}
+	return bip39.NewSeed(mnemonic, passphrase), nil
+}
+
+// DeriveKey derives the private key at the given BIP-32 path from a seed.
+func DeriveKey(seed []byte, path accounts.DerivationPath) (*ecdsa.PrivateKey, error) {
+	key, chainCode, err := hdChild([]byte("Bitcoin seed"), seed, nil)
+	if err != nil {
+		return nil, errors.New("seed does not generate a valid key")
+	}
+	for _, index := range path {
+		var data []byte
+		if index >= 0x80000000 {
+			// Hardened keys are derived from the private key.
+			data = append([]byte{0x00}, math.PaddedBigBytes(key, 32)...)
+		} else {
+			privKey, err := crypto.ToECDSA(math.PaddedBigBytes(key, 32))
+			if err != nil {
+				return nil, err
+			}
+			data = crypto.CompressPubkey(&privKey.PublicKey)
+		}
+		indexBytes := make([]byte, 4)
+		binary.BigEndian.PutUint32(indexBytes, index)
+		data = append(data, indexBytes...)
+		key, chainCode, err = hdChild(chainCode, data, key)
+		if err != nil {
+			return nil, fmt.Errorf("path %v does not generate a valid key", path)
+		}
+	}
+	return crypto.ToECDSA(math.PaddedBigBytes(key, 32))
+}
+
+// MnemonicKey derives the private key at the given path from a mnemonic and
+// optional passphrase.  If the path is empty the default path is used.
+func MnemonicKey(mnemonic string, passphrase string, path string, validate bool) (*ecdsa.PrivateKey, error) {
+	if path == "" {
+		path = DefaultHDPath
+	}
+	derivationPath, err := accounts.ParseDerivationPath(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid path %s: %v", path, err)
+	}
+	seed, err := MnemonicToSeed(mnemonic, passphrase, validate)
+	if err != nil {
+		return nil, err
+	}
+	return DeriveKey(seed, derivationPath)
+}
+
+// hdChild carries out a single step of BIP-32 derivation, returning the child
+// key and chain code.  If parent is nil the master key is generated.
+func hdChild(chainCode []byte, data []byte, parent *big.Int) (*big.Int, []byte, error) {
+	mac := hmac.New(sha512.New, chainCode)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+
+	n := crypto.S256().Params().N
+	key := new(big.Int).SetBytes(sum[:32])
+	if key.Cmp(n) >= 0 {
+		return nil, nil, errors.New("invalid key")
+	}
+	if parent != nil {
+		key.Add(key, parent)
+		key.Mod(key, n)
+	}
+	if key.Sign() == 0 {
+		return nil, nil, errors.New("invalid key")
+	}
+	return key, sum[32:], nil
+}
@@ -0,0 +1,136 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeriveKey(t *testing.T) {
+	// BIP-32 test vector 1.
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	require.Nil(t, err)
+
+	tests := []struct {
+		path string
+		key  string
+	}{
+		{
+			path: "m/0'",
+			key:  "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea",
+		},
+		{
+			path: "m/0'/1",
+			key:  "3c6cb8d0f6a264c91ea8b5030fadaa8e538b020f0a387421a12de9319dc93368",
+		},
+		{
+			path: "m/0'/1/2'",
+			key:  "cbce0d719ecf7431d88e6a89fa1483e02e35092af60c042b1df2ff59fa424dca",
+		},
+		{
+			path: "m/0'/1/2'/2",
+			key:  "0f479245fb19a38a1954c5c7c0ebab2f9bdfd96a17563ef28a6a4b1a2a764ef4",
+		},
+		{
+			path: "m/0'/1/2'/2/1000000000",
+			key:  "471b76e389e528d6de6d816857e012c5455051cad6660850e58372a6c3e6e7c8",
+		},
+	}
+
+	for i, test := range tests {
+		path, err := accounts.ParseDerivationPath(test.path)
+		require.Nil(t, err, fmt.Sprintf("failed to parse path at test %d", i))
+		key, err := DeriveKey(seed, path)
+		require.Nil(t, err, fmt.Sprintf("unexpected error at test %d", i))
+		assert.Equal(t, test.key, hex.EncodeToString(crypto.FromECDSA(key)), fmt.Sprintf("incorrect key at test %d", i))
+	}
+}
+
+func TestMnemonicKey(t *testing.T) {
+	tests := []struct {
+		mnemonic   string
+		passphrase string
+		path       string
+		validate   bool
+		address    string
+		err        string
+	}{
+		{ // 0 - default path
+			mnemonic: "test test test test test test test test test test test junk",
+			validate: true,
+			address:  "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+		},
+		{ // 1 - explicit path
+			mnemonic: "test test test test test test test test test test test junk",
+			path:     "m/44'/60'/0'/0/1",
+			validate: true,
+			address:  "0x70997970C51812dc3A010C7d01b50e0d17dc79C8",
+		},
+		{ // 2 - extra whitespace
+			mnemonic: "  abandon abandon abandon abandon abandon abandon\nabandon abandon abandon abandon abandon about ",
+			validate: true,
+			address:  "0x9858EfFD232B4033E47d90003D41EC34EcaEda94",
+		},
+		{ // 3 - bad checksum
+			mnemonic: "test test test test test test test test test test test test",
+			validate: true,
+			err:      "invalid mnemonic: Checksum incorrect",
+		},
+		{ // 4 - unknown word
+			mnemonic: "test test test test test test test test test test test junky",
+			validate: true,
+			err:      "invalid mnemonic: word `junky` not found in reverse map",
+		},
+		{ // 5 - bad checksum not validated
+			mnemonic: "test test test test test test test test test test test test",
+		},
+		{ // 6 - empty
+			mnemonic: " ",
+			err:      "no mnemonic supplied",
+		},
+		{ // 7 - bad path
+			mnemonic: "test test test test test test test test test test test junk",
+			path:     "m/bad",
+			err:      `invalid path m/bad: invalid component: bad`,
+		},
+	}
+
+	for i, test := range tests {
+		key, err := MnemonicKey(test.mnemonic, test.passphrase, test.path, test.validate)
+		if test.err != "" {
+			assert.EqualError(t, err, test.err, fmt.Sprintf("incorrect error at test %d", i))
+		} else {
+			require.Nil(t, err, fmt.Sprintf("unexpected error at test %d", i))
+			if test.address != "" {
+				assert.Equal(t, test.address, crypto.PubkeyToAddress(key.PublicKey).Hex(), fmt.Sprintf("incorrect address at test %d", i))
+			}
+		}
+	}
+}
+
+func TestMnemonicKeyPassphrase(t *testing.T) {
+	mnemonic := "test test test test test test test test test test test junk"
+	key1, err := MnemonicKey(mnemonic, "", "", true)
+	require.Nil(t, err)
+	key2, err := MnemonicKey(mnemonic, "secret", "", true)
+	require.Nil(t, err)
+	assert.NotEqual(t, crypto.PubkeyToAddress(key1.PublicKey), crypto.PubkeyToAddress(key2.PublicKey))
+}
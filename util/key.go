@@ -5,6 +5,7 @@ import (
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/ecdsa"
+	"crypto/rand"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
@@ -16,6 +17,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pborman/uuid"
 	"github.com/wealdtech/ethereal/cli"
@@ -35,33 +37,115 @@ func PrivateKeyForAccount(chainID *big.Int, address common.Address, passphrase s
 	if err != nil {
 		return nil, fmt.Errorf("unable to export keystore for %v", address)
 	}
-	m := make(map[string]interface{})
-	err = json.Unmarshal(exported, &m)
+	key, err := DecryptKeyJSON(exported, passphrase)
 	if err != nil {
 		return nil, fmt.Errorf("unable to unmarshal keystore for %v", address)
 	}
+	return key, nil
+}
+
+// DecryptKeyJSON decrypts a V1 or V3 keystore with the given passphrase.
+func DecryptKeyJSON(keyJSON []byte, passphrase string) (*ecdsa.PrivateKey, error) {
+	m := make(map[string]interface{})
+	if err := json.Unmarshal(keyJSON, &m); err != nil {
+		return nil, err
+	}
 	var keyBytes []byte
+	var err error
 	if version, ok := m["version"].(string); ok && version == "1" {
 		k := new(encryptedKeyJSONV1)
-		err = json.Unmarshal(exported, k)
-		if err != nil {
-			return nil, fmt.Errorf("unable to unmarshal keystore for %v", address)
+		if err := json.Unmarshal(keyJSON, k); err != nil {
+			return nil, err
 		}
 		keyBytes, _, err = decryptKeyV1(k, passphrase)
 	} else {
 		k := new(encryptedKeyJSONV3)
-		err = json.Unmarshal(exported, k)
-		if err != nil {
-			return nil, fmt.Errorf("unable to unmarshal keystore for %v", address)
+		if err := json.Unmarshal(keyJSON, k); err != nil {
+			return nil, err
 		}
 		keyBytes, _, err = decryptKeyV3(k, passphrase)
 	}
 	if err != nil {
-		return nil, fmt.Errorf("unable to unmarshal keystore for %v", address)
+		return nil, err
 	}
 	return crypto.ToECDSAUnsafe(keyBytes), nil
 }
 
+// KeystoreParams are the parameters used to encrypt a keystore.
+type KeystoreParams struct {
+	// KDF is the key derivation function, either "scrypt" or "pbkdf2".
+	KDF string
+	// ScryptN is the scrypt CPU/memory cost parameter.
+	ScryptN int
+	// ScryptP is the scrypt parallelisation parameter.
+	ScryptP int
+	// PBKDF2C is the number of PBKDF2 iterations.
+	PBKDF2C int
+}
+
+// EncryptKey encrypts a key with a passphrase, returning a V3 keystore.
+func EncryptKey(key *ecdsa.PrivateKey, passphrase string, params *KeystoreParams) ([]byte, error) {
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	kdfParams := map[string]interface{}{
+		"dklen": scryptDKLen,
+		"salt":  hex.EncodeToString(salt),
+	}
+	var derivedKey []byte
+	switch params.KDF {
+	case keyHeaderKDF:
+		if params.ScryptN <= 1 || params.ScryptN&(params.ScryptN-1) != 0 {
+			return nil, fmt.Errorf("scrypt N %d must be a power of 2 greater than 1", params.ScryptN)
+		}
+		if params.ScryptP <= 0 {
+			return nil, fmt.Errorf("scrypt P %d must be greater than 0", params.ScryptP)
+		}
+		var err error
+		derivedKey, err = scrypt.Key([]byte(passphrase), salt, params.ScryptN, scryptR, params.ScryptP, scryptDKLen)
+		if err != nil {
+			return nil, err
+		}
+		kdfParams["n"] = params.ScryptN
+		kdfParams["r"] = scryptR
+		kdfParams["p"] = params.ScryptP
+	case "pbkdf2":
+		if params.PBKDF2C <= 0 {
+			return nil, fmt.Errorf("PBKDF2 iterations %d must be greater than 0", params.PBKDF2C)
+		}
+		derivedKey = pbkdf2.Key([]byte(passphrase), salt, params.PBKDF2C, scryptDKLen, sha256.New)
+		kdfParams["c"] = params.PBKDF2C
+		kdfParams["prf"] = "hmac-sha256"
+	default:
+		return nil, fmt.Errorf("unsupported KDF %s", params.KDF)
+	}
+
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(iv); err != nil {
+		return nil, err
+	}
+	cipherText, err := aesCTRXOR(derivedKey[:16], math.PaddedBigBytes(key.D, 32), iv)
+	if err != nil {
+		return nil, err
+	}
+	mac := crypto.Keccak256(derivedKey[16:32], cipherText)
+
+	return json.Marshal(&encryptedKeyJSONV3{
+		Address: hex.EncodeToString(crypto.PubkeyToAddress(key.PublicKey).Bytes()),
+		Crypto: cryptoJSON{
+			Cipher:       "aes-128-ctr",
+			CipherText:   hex.EncodeToString(cipherText),
+			CipherParams: cipherparamsJSON{IV: hex.EncodeToString(iv)},
+			KDF:          params.KDF,
+			KDFParams:    kdfParams,
+			MAC:          hex.EncodeToString(mac),
+		},
+		ID:      uuid.NewRandom().String(),
+		Version: version,
+	})
+}
+
 const (
 	keyHeaderKDF = "scrypt"
 
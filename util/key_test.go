@@ -0,0 +1,84 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptKey(t *testing.T) {
+	key, err := crypto.HexToECDSA("ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80")
+	require.Nil(t, err)
+
+	tests := []struct {
+		params *KeystoreParams
+		err    string
+	}{
+		{ // 0 - scrypt
+			params: &KeystoreParams{KDF: "scrypt", ScryptN: LightScryptN, ScryptP: LightScryptP},
+		},
+		{ // 1 - pbkdf2
+			params: &KeystoreParams{KDF: "pbkdf2", PBKDF2C: 1024},
+		},
+		{ // 2 - bad scrypt N
+			params: &KeystoreParams{KDF: "scrypt", ScryptN: 1000, ScryptP: 1},
+			err:    "scrypt N 1000 must be a power of 2 greater than 1",
+		},
+		{ // 3 - bad scrypt P
+			params: &KeystoreParams{KDF: "scrypt", ScryptN: 1024, ScryptP: 0},
+			err:    "scrypt P 0 must be greater than 0",
+		},
+		{ // 4 - bad PBKDF2 iterations
+			params: &KeystoreParams{KDF: "pbkdf2"},
+			err:    "PBKDF2 iterations 0 must be greater than 0",
+		},
+		{ // 5 - unknown KDF
+			params: &KeystoreParams{KDF: "argon2"},
+			err:    "unsupported KDF argon2",
+		},
+	}
+
+	for i, test := range tests {
+		keyJSON, err := EncryptKey(key, "secret", test.params)
+		if test.err != "" {
+			assert.EqualError(t, err, test.err, fmt.Sprintf("incorrect error at test %d", i))
+			continue
+		}
+		require.Nil(t, err, fmt.Sprintf("unexpected error at test %d", i))
+
+		data := make(map[string]interface{})
+		require.Nil(t, json.Unmarshal(keyJSON, &data), fmt.Sprintf("invalid JSON at test %d", i))
+		assert.Equal(t, "f39fd6e51aad88f6f4ce6ab8827279cfffb92266", data["address"], fmt.Sprintf("incorrect address at test %d", i))
+		assert.Equal(t, float64(3), data["version"], fmt.Sprintf("incorrect version at test %d", i))
+
+		decrypted, err := DecryptKeyJSON(keyJSON, "secret")
+		require.Nil(t, err, fmt.Sprintf("failed to decrypt at test %d", i))
+		assert.Equal(t, key.D, decrypted.D, fmt.Sprintf("incorrect key at test %d", i))
+
+		// Ensure that the keystore can be read by Geth.
+		gethKey, err := keystore.DecryptKey(keyJSON, "secret")
+		require.Nil(t, err, fmt.Sprintf("failed to decrypt with Geth at test %d", i))
+		assert.Equal(t, key.D, gethKey.PrivateKey.D, fmt.Sprintf("incorrect Geth key at test %d", i))
+
+		_, err = DecryptKeyJSON(keyJSON, "wrong")
+		assert.NotNil(t, err, fmt.Sprintf("decrypted with incorrect passphrase at test %d", i))
+	}
+}
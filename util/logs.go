@@ -0,0 +1,189 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// LogFilterer is the interface required to fetch logs.
+type LogFilterer interface {
+	FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error)
+}
+
+// logsLimitErrors are fragments of the errors returned by nodes and node
+// providers when a request for logs covers too many blocks or results.  They
+// are specific to limits, as other errors such as an invalid block range would
+// otherwise be retried with ever smaller chunks.
+var logsLimitErrors = []string{
+	"query returned more than",
+	"response size exceeded",
+	"query timeout exceeded",
+	"eth_getlogs is limited to",
+	"block range is too wide",
+	"block range is too large",
+	"block range too large",
+	"exceed maximum block range",
+	"requested too many blocks",
+}
+
+// logsRateLimitErrors are fragments of the errors returned by node providers
+// when requests are being made too quickly.
+var logsRateLimitErrors = []string{
+	"rate limit exceeded",
+	"too many requests",
+}
+
+// logsRateLimitRetries is the number of times a rate-limited request is
+// retried before giving up.
+var logsRateLimitRetries = 5
+
+// logsRateLimitBackoff is the initial delay before retrying a rate-limited
+// request; it doubles with each retry.
+var logsRateLimitBackoff = time.Second
+
+// isLogsLimitError returns true if the error is a node complaining about the
+// size of a request for logs.
+func isLogsLimitError(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	return containsFragment(err, logsLimitErrors)
+}
+
+// isRateLimitError returns true if the error is a node provider complaining
+// about the rate of requests.
+func isRateLimitError(err error) bool {
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	return containsFragment(err, logsRateLimitErrors)
+}
+
+// containsFragment returns true if the error message contains any of the
+// fragments, ignoring case.
+func containsFragment(err error, fragments []string) bool {
+	msg := strings.ToLower(err.Error())
+	for _, fragment := range fragments {
+		if strings.Contains(msg, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// FetchLogs fetches the logs matching the query between the from and to
+// blocks inclusive.  Logs are requested in chunks of at most chunkSize
+// blocks; if the node complains about the size of a request the chunk is
+// halved and the request retried, and the chunk grows back towards chunkSize
+// after each successful request.  If the node provider rate limits a request
+// it is retried after an increasing delay, without altering the chunk.  If
+// timeout is non-zero it applies to each request.
+func FetchLogs(ctx context.Context,
+	filterer LogFilterer,
+	query ethereum.FilterQuery,
+	from uint64,
+	to uint64,
+	chunkSize uint64,
+	timeout time.Duration,
+) ([]types.Log, error) {
+	if chunkSize == 0 {
+		return nil, errors.New("chunk size must be greater than 0")
+	}
+	if from > to {
+		return nil, errors.New("from block is after to block")
+	}
+
+	logs := make([]types.Log, 0)
+	size := chunkSize
+	retries := 0
+	backoff := logsRateLimitBackoff
+	for start := from; ; {
+		end := start + size - 1
+		if end > to || end < start {
+			end = to
+		}
+		query.FromBlock = new(big.Int).SetUint64(start)
+		query.ToBlock = new(big.Int).SetUint64(end)
+		chunkLogs, err := filterLogs(ctx, filterer, query, timeout)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, err
+			}
+			if isRateLimitError(err) {
+				if retries == logsRateLimitRetries {
+					return nil, err
+				}
+				retries++
+				if err := sleep(ctx, backoff); err != nil {
+					return nil, err
+				}
+				backoff *= 2
+				continue
+			}
+			if size == 1 || !isLogsLimitError(err) {
+				return nil, err
+			}
+			size /= 2
+			continue
+		}
+		retries = 0
+		backoff = logsRateLimitBackoff
+		logs = append(logs, chunkLogs...)
+		if end == to {
+			break
+		}
+		start = end + 1
+		if size < chunkSize {
+			size *= 2
+			if size > chunkSize {
+				size = chunkSize
+			}
+		}
+	}
+	return logs, nil
+}
+
+// filterLogs makes a single request for logs.
+func filterLogs(ctx context.Context, filterer LogFilterer, query ethereum.FilterQuery, timeout time.Duration) ([]types.Log, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return filterer.FilterLogs(ctx, query)
+}
+
+// sleep waits for the given duration, returning early if the context is
+// cancelled.
+func sleep(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
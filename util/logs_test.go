@@ -0,0 +1,182 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubFilterer returns one log per block, complaining if a request would
+// return more than maxLogs logs.  The first rateLimited requests are rejected
+// as if the provider were rate limiting.
+type stubFilterer struct {
+	maxLogs     uint64
+	rateLimited int
+	err         error
+	requests    [][2]uint64
+}
+
+func (s *stubFilterer) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	from := query.FromBlock.Uint64()
+	to := query.ToBlock.Uint64()
+	s.requests = append(s.requests, [2]uint64{from, to})
+	if s.err != nil {
+		return nil, s.err
+	}
+	if s.rateLimited > 0 {
+		s.rateLimited--
+		return nil, rpc.HTTPError{StatusCode: http.StatusTooManyRequests, Status: "429 Too Many Requests"}
+	}
+	if to-from+1 > s.maxLogs {
+		return nil, fmt.Errorf("query returned more than %d results", s.maxLogs)
+	}
+	logs := make([]types.Log, 0)
+	for block := from; block <= to; block++ {
+		logs = append(logs, types.Log{BlockNumber: block})
+	}
+	return logs, nil
+}
+
+func TestFetchLogs(t *testing.T) {
+	backoff := logsRateLimitBackoff
+	logsRateLimitBackoff = time.Millisecond
+	defer func() { logsRateLimitBackoff = backoff }()
+
+	tests := []struct {
+		from        uint64
+		to          uint64
+		chunkSize   uint64
+		maxLogs     uint64
+		rateLimited int
+		err         error
+		requests    [][2]uint64
+		errStr      string
+	}{
+		{ // 0 - single chunk
+			from: 10, to: 19, chunkSize: 100, maxLogs: 100,
+			requests: [][2]uint64{{10, 19}},
+		},
+		{ // 1 - multiple chunks
+			from: 0, to: 24, chunkSize: 10, maxLogs: 100,
+			requests: [][2]uint64{{0, 9}, {10, 19}, {20, 24}},
+		},
+		{ // 2 - shrinking and growing chunks
+			from: 0, to: 19, chunkSize: 8, maxLogs: 4,
+			requests: [][2]uint64{{0, 7}, {0, 3}, {4, 11}, {4, 7}, {8, 15}, {8, 11}, {12, 19}, {12, 15}, {16, 19}},
+		},
+		{ // 3 - single block
+			from: 5, to: 5, chunkSize: 10, maxLogs: 1,
+			requests: [][2]uint64{{5, 5}},
+		},
+		{ // 4 - too many logs in a single block
+			from: 5, to: 6, chunkSize: 2, maxLogs: 0,
+			requests: [][2]uint64{{5, 6}, {5, 5}},
+			errStr:   "query returned more than 0 results",
+		},
+		{ // 5 - other error
+			from: 0, to: 100, chunkSize: 10, err: errors.New("connection refused"),
+			requests: [][2]uint64{{0, 9}},
+			errStr:   "connection refused",
+		},
+		{ // 6 - invalid range
+			from: 10, to: 9, chunkSize: 10,
+			errStr: "from block is after to block",
+		},
+		{ // 7 - invalid chunk size
+			from: 0, to: 9, chunkSize: 0,
+			errStr: "chunk size must be greater than 0",
+		},
+		{ // 8 - rate limited
+			from: 0, to: 19, chunkSize: 10, maxLogs: 100, rateLimited: 2,
+			requests: [][2]uint64{{0, 9}, {0, 9}, {0, 9}, {10, 19}},
+		},
+		{ // 9 - rate limited too many times
+			from: 0, to: 19, chunkSize: 10, maxLogs: 100, rateLimited: 10,
+			requests: [][2]uint64{{0, 9}, {0, 9}, {0, 9}, {0, 9}, {0, 9}, {0, 9}},
+			errStr:   "429 Too Many Requests",
+		},
+	}
+
+	for i, test := range tests {
+		filterer := &stubFilterer{maxLogs: test.maxLogs, rateLimited: test.rateLimited, err: test.err}
+		logs, err := FetchLogs(context.Background(), filterer, ethereum.FilterQuery{}, test.from, test.to, test.chunkSize, 0)
+		if test.errStr != "" {
+			require.NotNil(t, err, fmt.Sprintf("missing expected error at test %d", i))
+			assert.Equal(t, test.errStr, err.Error(), fmt.Sprintf("incorrect error at test %d", i))
+		} else {
+			require.Nil(t, err, fmt.Sprintf("unexpected error at test %d", i))
+			require.Len(t, logs, int(test.to-test.from+1), fmt.Sprintf("incorrect number of logs at test %d", i))
+			for j := range logs {
+				assert.Equal(t, test.from+uint64(j), logs[j].BlockNumber, fmt.Sprintf("incorrect log %d at test %d", j, i))
+			}
+		}
+		if len(test.requests) > 0 {
+			assert.Equal(t, test.requests, filterer.requests, fmt.Sprintf("incorrect requests at test %d", i))
+		}
+	}
+}
+
+func TestIsLogsLimitError(t *testing.T) {
+	tests := []struct {
+		err    error
+		result bool
+	}{
+		{err: errors.New("query returned more than 10000 results"), result: true},
+		{err: errors.New("Log response size exceeded. You can make eth_getLogs requests with up to a 2K block range"), result: true},
+		{err: errors.New("query timeout exceeded"), result: true},
+		{err: errors.New("eth_getLogs is limited to a 10,000 block range"), result: true},
+		{err: errors.New("block range is too wide"), result: true},
+		{err: errors.New("exceed maximum block range: 5000"), result: true},
+		{err: errors.New("requested too many blocks from 0 to 20000, maximum is set to 10000"), result: true},
+		{err: context.DeadlineExceeded, result: true},
+		{err: errors.New("connection refused"), result: false},
+		{err: errors.New("invalid argument 0: hex string without 0x prefix"), result: false},
+		{err: errors.New("invalid block range params"), result: false},
+		{err: errors.New("invalid block range: from block is after to block"), result: false},
+		{err: errors.New("daily request count exceeded, request rate limited"), result: false},
+		{err: errors.New("rate limit exceeded"), result: false},
+		{err: errors.New("i/o timeout"), result: false},
+	}
+
+	for i, test := range tests {
+		assert.Equal(t, test.result, isLogsLimitError(test.err), fmt.Sprintf("incorrect result at test %d", i))
+	}
+}
+
+func TestIsRateLimitError(t *testing.T) {
+	tests := []struct {
+		err    error
+		result bool
+	}{
+		{err: rpc.HTTPError{StatusCode: http.StatusTooManyRequests, Status: "429 Too Many Requests"}, result: true},
+		{err: errors.New("project ID request rate limit exceeded"), result: true},
+		{err: rpc.HTTPError{StatusCode: http.StatusBadGateway, Status: "502 Bad Gateway"}, result: false},
+		{err: errors.New("query returned more than 10000 results"), result: false},
+	}
+
+	for i, test := range tests {
+		assert.Equal(t, test.result, isRateLimitError(test.err), fmt.Sprintf("incorrect result at test %d", i))
+	}
+}
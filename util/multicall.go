@@ -0,0 +1,113 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/wealdtech/ethereal/util/contracts"
+)
+
+// Multicall3Address is the address at which Multicall3 is deployed on most
+// chains.
+var Multicall3Address = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+// MulticallBatchSize is the maximum number of calls made through a single
+// call to Multicall3.
+var MulticallBatchSize = 500
+
+// MulticallAvailable returns true if Multicall3 is deployed on the chain.
+func MulticallAvailable(ctx context.Context, caller bind.ContractCaller) (bool, error) {
+	code, err := caller.CodeAt(ctx, Multicall3Address, nil)
+	if err != nil {
+		return false, err
+	}
+	return len(code) > 0, nil
+}
+
+// Multicall makes a number of read-only calls, returning a result for each.
+// If Multicall3 is deployed on the chain the calls are made in batches through
+// its aggregate3 method, otherwise they are made individually.  Either way, a
+// call that fails is returned as an unsuccessful result containing its revert
+// data if it allows failure, otherwise an error is returned.
+func Multicall(ctx context.Context, caller bind.ContractCaller, from common.Address, calls []contracts.Multicall3Call3) ([]contracts.Multicall3Result, error) {
+	available, err := MulticallAvailable(ctx, caller)
+	if err != nil {
+		return nil, err
+	}
+	if !available {
+		return multicallIndividually(ctx, caller, from, calls)
+	}
+
+	multicall, err := contracts.NewMulticall3Caller(Multicall3Address, caller)
+	if err != nil {
+		return nil, err
+	}
+	raw := &contracts.Multicall3CallerRaw{Contract: multicall}
+	opts := &bind.CallOpts{Context: ctx, From: from}
+	results := make([]contracts.Multicall3Result, 0, len(calls))
+	for start := 0; start < len(calls); start += MulticallBatchSize {
+		end := start + MulticallBatchSize
+		if end > len(calls) {
+			end = len(calls)
+		}
+		var out []interface{}
+		if err := raw.Call(opts, &out, "aggregate3", calls[start:end]); err != nil {
+			return nil, fmt.Errorf("multicall failed: %v", err)
+		}
+		if len(out) != 1 {
+			return nil, errors.New("unexpected output from multicall")
+		}
+		batchResults := *abi.ConvertType(out[0], new([]contracts.Multicall3Result)).(*[]contracts.Multicall3Result)
+		if len(batchResults) != end-start {
+			return nil, fmt.Errorf("multicall returned %d results for %d calls", len(batchResults), end-start)
+		}
+		results = append(results, batchResults...)
+	}
+	return results, nil
+}
+
+// multicallIndividually makes each of the calls separately, for chains
+// without Multicall3.
+func multicallIndividually(ctx context.Context, caller bind.ContractCaller, from common.Address, calls []contracts.Multicall3Call3) ([]contracts.Multicall3Result, error) {
+	results := make([]contracts.Multicall3Result, len(calls))
+	for i := range calls {
+		target := calls[i].Target
+		returnData, err := caller.CallContract(ctx, ethereum.CallMsg{
+			From: from,
+			To:   &target,
+			Data: calls[i].CallData,
+		}, nil)
+		if err != nil {
+			revertData, reverted := RevertData(err)
+			if !reverted && !strings.Contains(err.Error(), "execution reverted") {
+				return nil, err
+			}
+			if !calls[i].AllowFailure {
+				return nil, fmt.Errorf("call %d reverted", i)
+			}
+			results[i] = contracts.Multicall3Result{Success: false, ReturnData: revertData}
+			continue
+		}
+		results[i] = contracts.Multicall3Result{Success: true, ReturnData: returnData}
+	}
+	return results, nil
+}
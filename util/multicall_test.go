@@ -0,0 +1,168 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wealdtech/ethereal/util/contracts"
+)
+
+// stubCaller answers calls from a fixed set of responses keyed by call data,
+// optionally acting as Multicall3.
+type stubCaller struct {
+	multicall bool
+	responses map[string][]byte
+	calls     int
+}
+
+func (s *stubCaller) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	if s.multicall && contract == Multicall3Address {
+		return []byte{0x01}, nil
+	}
+	return nil, nil
+}
+
+func (s *stubCaller) call(data []byte) ([]byte, bool) {
+	res, exists := s.responses[fmt.Sprintf("%x", data)]
+	return res, exists
+}
+
+func (s *stubCaller) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	s.calls++
+	if *msg.To != Multicall3Address {
+		if res, exists := s.call(msg.Data); exists {
+			return res, nil
+		}
+		return nil, errors.New("execution reverted")
+	}
+
+	// Act as Multicall3
+	multicallAbi, err := abi.JSON(strings.NewReader(contracts.Multicall3ABI))
+	if err != nil {
+		return nil, err
+	}
+	method := multicallAbi.Methods["aggregate3"]
+	if !bytes.Equal(msg.Data[:4], method.ID) {
+		return nil, errors.New("unexpected method")
+	}
+	args, err := method.Inputs.Unpack(msg.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	calls := *abi.ConvertType(args[0], new([]contracts.Multicall3Call3)).(*[]contracts.Multicall3Call3)
+	results := make([]contracts.Multicall3Result, len(calls))
+	for i, call := range calls {
+		res, exists := s.call(call.CallData)
+		if !exists && !call.AllowFailure {
+			return nil, errors.New("execution reverted")
+		}
+		results[i] = contracts.Multicall3Result{Success: exists, ReturnData: res}
+	}
+	return method.Outputs.Pack(results)
+}
+
+func TestMulticall(t *testing.T) {
+	target := common.HexToAddress("0xd26114cd6EE289AccF82350c8d8487fedB8A0C07")
+	responses := map[string][]byte{
+		"01": common.LeftPadBytes([]byte{0x01}, 32),
+		"02": common.LeftPadBytes([]byte{0x02}, 32),
+	}
+	tests := []struct {
+		calls  []contracts.Multicall3Call3
+		output []contracts.Multicall3Result
+		err    bool
+	}{
+		{ // 0 - none
+			calls:  []contracts.Multicall3Call3{},
+			output: []contracts.Multicall3Result{},
+		},
+		{ // 1 - successful calls
+			calls: []contracts.Multicall3Call3{
+				{Target: target, CallData: []byte{0x01}},
+				{Target: target, CallData: []byte{0x02}},
+			},
+			output: []contracts.Multicall3Result{
+				{Success: true, ReturnData: responses["01"]},
+				{Success: true, ReturnData: responses["02"]},
+			},
+		},
+		{ // 2 - allowed failure
+			calls: []contracts.Multicall3Call3{
+				{Target: target, CallData: []byte{0x01}},
+				{Target: target, AllowFailure: true, CallData: []byte{0x03}},
+			},
+			output: []contracts.Multicall3Result{
+				{Success: true, ReturnData: responses["01"]},
+				{Success: false, ReturnData: []byte{}},
+			},
+		},
+		{ // 3 - failure not allowed
+			calls: []contracts.Multicall3Call3{
+				{Target: target, CallData: []byte{0x03}},
+			},
+			err: true,
+		},
+	}
+
+	for _, multicall := range []bool{true, false} {
+		for i, tt := range tests {
+			caller := &stubCaller{multicall: multicall, responses: responses}
+			output, err := Multicall(context.Background(), caller, common.Address{}, tt.calls)
+			if tt.err {
+				assert.NotNil(t, err, fmt.Sprintf("missing expected error at test %d (multicall %v)", i, multicall))
+				continue
+			}
+			require.Nil(t, err, fmt.Sprintf("unexpected error at test %d (multicall %v)", i, multicall))
+			require.Len(t, output, len(tt.output), fmt.Sprintf("incorrect number of results at test %d (multicall %v)", i, multicall))
+			for j := range tt.output {
+				assert.Equal(t, tt.output[j].Success, output[j].Success, fmt.Sprintf("incorrect success at test %d result %d (multicall %v)", i, j, multicall))
+				assert.Equal(t, len(tt.output[j].ReturnData), len(output[j].ReturnData), fmt.Sprintf("incorrect return data at test %d result %d (multicall %v)", i, j, multicall))
+				assert.True(t, bytes.Equal(tt.output[j].ReturnData, output[j].ReturnData), fmt.Sprintf("incorrect return data at test %d result %d (multicall %v)", i, j, multicall))
+			}
+		}
+	}
+}
+
+func TestMulticallBatches(t *testing.T) {
+	defer func(size int) { MulticallBatchSize = size }(MulticallBatchSize)
+	MulticallBatchSize = 2
+
+	target := common.HexToAddress("0xd26114cd6EE289AccF82350c8d8487fedB8A0C07")
+	responses := map[string][]byte{}
+	calls := make([]contracts.Multicall3Call3, 5)
+	for i := range calls {
+		calls[i] = contracts.Multicall3Call3{Target: target, CallData: []byte{byte(i)}}
+		responses[fmt.Sprintf("%02x", i)] = common.LeftPadBytes([]byte{byte(i)}, 32)
+	}
+	caller := &stubCaller{multicall: true, responses: responses}
+	output, err := Multicall(context.Background(), caller, common.Address{}, calls)
+	require.Nil(t, err)
+	require.Len(t, output, 5)
+	assert.Equal(t, 3, caller.calls)
+	for i := range output {
+		assert.Equal(t, responses[fmt.Sprintf("%02x", i)], output[i].ReturnData, fmt.Sprintf("incorrect return data at result %d", i))
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/wealdtech/ethereal/util/contracts"
+)
+
+// Interface IDs of token standards, as reported through ERC-165.
+var (
+	InterfaceIDERC165             = [4]byte{0x01, 0xff, 0xc9, 0xa7}
+	InterfaceIDERC721             = [4]byte{0x80, 0xac, 0x58, 0xcd}
+	InterfaceIDERC721Metadata     = [4]byte{0x5b, 0x5e, 0x13, 0x9f}
+	InterfaceIDERC721Enumerable   = [4]byte{0x78, 0x0e, 0x9d, 0x63}
+	InterfaceIDERC1155            = [4]byte{0xd9, 0xb6, 0x7a, 0x26}
+	InterfaceIDERC1155MetadataURI = [4]byte{0x0e, 0x89, 0x34, 0x1c}
+)
+
+// interfaceIDInvalid must not be supported by any contract that implements ERC-165.
+var interfaceIDInvalid = [4]byte{0xff, 0xff, 0xff, 0xff}
+
+// SupportsInterface returns true if the contract at the address reports that
+// it implements the interface, as per ERC-165.
+func SupportsInterface(ctx context.Context, caller bind.ContractCaller, address common.Address, interfaceID [4]byte) bool {
+	contract, err := contracts.NewERC165Caller(address, caller)
+	if err != nil {
+		return false
+	}
+	opts := &bind.CallOpts{Context: ctx}
+
+	// Calls that fail are treated as the interface not being supported, as
+	// contracts that do not implement ERC-165 revert or do not exist.
+	if supported, err := contract.SupportsInterface(opts, InterfaceIDERC165); err != nil || !supported {
+		return false
+	}
+	if supported, err := contract.SupportsInterface(opts, interfaceIDInvalid); err != nil || supported {
+		return false
+	}
+	if interfaceID == InterfaceIDERC165 {
+		return true
+	}
+	supported, err := contract.SupportsInterface(opts, interfaceID)
+	return err == nil && supported
+}
+
+// ERC1155URI returns the URI for an ERC-1155 token, replacing any instances
+// of {id} in the URI returned by the contract with the token ID in
+// lowercase hex, zero-padded to 64 characters.
+func ERC1155URI(uri string, id *big.Int) string {
+	return strings.ReplaceAll(uri, "{id}", fmt.Sprintf("%064x", id))
+}
+
+// IsDataURI returns true if the URI is a data: URI.
+func IsDataURI(uri string) bool {
+	return len(uri) >= 5 && strings.EqualFold(uri[:5], "data:")
+}
+
+// DecodeDataURI decodes a data: URI as defined in RFC 2397, returning its
+// media type and data.
+func DecodeDataURI(uri string) (string, []byte, error) {
+	if !IsDataURI(uri) {
+		return "", nil, errors.New("not a data URI")
+	}
+	parts := strings.SplitN(uri[5:], ",", 2)
+	if len(parts) != 2 {
+		return "", nil, errors.New("data URI has no data")
+	}
+
+	params := strings.Split(parts[0], ";")
+	isBase64 := false
+	if len(params) > 1 && strings.EqualFold(params[len(params)-1], "base64") {
+		isBase64 = true
+		params = params[:len(params)-1]
+	}
+	mediaType := strings.ToLower(strings.TrimSpace(params[0]))
+	if mediaType == "" {
+		mediaType = "text/plain"
+	}
+
+	if isBase64 {
+		data, err := base64.StdEncoding.DecodeString(parts[1])
+		if err != nil {
+			// Some contracts omit the padding.
+			data, err = base64.RawStdEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+			if err != nil {
+				return "", nil, fmt.Errorf("invalid base64 data: %v", err)
+			}
+		}
+		return mediaType, data, nil
+	}
+	data, err := url.PathUnescape(parts[1])
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid data: %v", err)
+	}
+	return mediaType, []byte(data), nil
+}
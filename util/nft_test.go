@@ -0,0 +1,178 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeDataURI(t *testing.T) {
+	tests := []struct {
+		uri       string
+		mediaType string
+		data      string
+		err       string
+	}{
+		{ // 0
+			uri:       "data:application/json;base64,eyJuYW1lIjoiVGVzdCJ9",
+			mediaType: "application/json",
+			data:      `{"name":"Test"}`,
+		},
+		{ // 1 - unpadded base64
+			uri:       "data:application/json;base64,eyJuYW1lIjoiVGVzdCAxIn0",
+			mediaType: "application/json",
+			data:      `{"name":"Test 1"}`,
+		},
+		{ // 2
+			uri:       "data:application/json;utf8,{\"name\":\"Test\"}",
+			mediaType: "application/json",
+			data:      `{"name":"Test"}`,
+		},
+		{ // 3
+			uri:       "DATA:Image/SVG+XML;charset=utf-8,%3Csvg%3E%3C%2Fsvg%3E",
+			mediaType: "image/svg+xml",
+			data:      "<svg></svg>",
+		},
+		{ // 4
+			uri:       "data:,Hello%2C%20world",
+			mediaType: "text/plain",
+			data:      "Hello, world",
+		},
+		{ // 5
+			uri: "https://example.com/1.json",
+			err: "not a data URI",
+		},
+		{ // 6
+			uri: "data:application/json",
+			err: "data URI has no data",
+		},
+		{ // 7
+			uri: "data:application/json;base64,!!!",
+			err: "invalid base64 data: illegal base64 data at input byte 0",
+		},
+		{ // 8
+			uri: "data:text/plain,%zz",
+			err: `invalid data: invalid URL escape "%zz"`,
+		},
+	}
+
+	for i, test := range tests {
+		mediaType, data, err := DecodeDataURI(test.uri)
+		if test.err != "" {
+			assert.EqualError(t, err, test.err, fmt.Sprintf("incorrect error at test %d", i))
+			continue
+		}
+		require.Nil(t, err, fmt.Sprintf("unexpected error at test %d", i))
+		assert.Equal(t, test.mediaType, mediaType, fmt.Sprintf("incorrect media type at test %d", i))
+		assert.Equal(t, test.data, string(data), fmt.Sprintf("incorrect data at test %d", i))
+	}
+}
+
+func TestERC1155URI(t *testing.T) {
+	tests := []struct {
+		uri    string
+		id     *big.Int
+		output string
+	}{
+		{ // 0 - no substitution
+			uri:    "https://example.com/token/1.json",
+			id:     big.NewInt(1),
+			output: "https://example.com/token/1.json",
+		},
+		{ // 1
+			uri:    "https://example.com/token/{id}.json",
+			id:     big.NewInt(314592),
+			output: "https://example.com/token/000000000000000000000000000000000000000000000000000000000004cce0.json",
+		},
+		{ // 2 - multiple
+			uri:    "ipfs://{id}/{id}",
+			id:     big.NewInt(0),
+			output: "ipfs://0000000000000000000000000000000000000000000000000000000000000000/0000000000000000000000000000000000000000000000000000000000000000",
+		},
+	}
+
+	for i, test := range tests {
+		output := ERC1155URI(test.uri, test.id)
+		assert.Equal(t, test.output, output, fmt.Sprintf("incorrect output at test %d", i))
+	}
+}
+
+func TestSupportsInterface(t *testing.T) {
+	// Supports every interface other than 0xffffffff.
+	erc165Code := []byte{
+		byte(vm.PUSH1), 4, byte(vm.CALLDATALOAD), byte(vm.PUSH1), 224, byte(vm.SHR),
+		byte(vm.PUSH4), 0xff, 0xff, 0xff, 0xff, byte(vm.EQ), byte(vm.ISZERO),
+		byte(vm.PUSH1), 0, byte(vm.MSTORE), byte(vm.PUSH1), 32, byte(vm.PUSH1), 0, byte(vm.RETURN),
+	}
+	// Claims to support every interface, so does not implement ERC-165.
+	allCode := []byte{
+		byte(vm.PUSH1), 1, byte(vm.PUSH1), 0, byte(vm.MSTORE), byte(vm.PUSH1), 32, byte(vm.PUSH1), 0, byte(vm.RETURN),
+	}
+	// Reverts.
+	revertCode := []byte{byte(vm.PUSH1), 0, byte(vm.DUP1), byte(vm.REVERT)}
+
+	erc165 := common.HexToAddress("0x0000000000000000000000000000000000000165")
+	all := common.HexToAddress("0x00000000000000000000000000000000000000a1")
+	reverts := common.HexToAddress("0x00000000000000000000000000000000000000a2")
+	backend := backends.NewSimulatedBackend(core.GenesisAlloc{
+		erc165:  {Code: erc165Code, Balance: big.NewInt(0)},
+		all:     {Code: allCode, Balance: big.NewInt(0)},
+		reverts: {Code: revertCode, Balance: big.NewInt(0)},
+	}, 30000000)
+	defer backend.Close()
+
+	tests := []struct {
+		address   common.Address
+		id        [4]byte
+		supported bool
+	}{
+		{ // 0
+			address:   erc165,
+			id:        InterfaceIDERC165,
+			supported: true,
+		},
+		{ // 1
+			address:   erc165,
+			id:        InterfaceIDERC721,
+			supported: true,
+		},
+		{ // 2
+			address: all,
+			id:      InterfaceIDERC721,
+		},
+		{ // 3
+			address: reverts,
+			id:      InterfaceIDERC721,
+		},
+		{ // 4 - no code
+			address: common.HexToAddress("0x00000000000000000000000000000000000000a3"),
+			id:      InterfaceIDERC721,
+		},
+	}
+
+	for i, test := range tests {
+		supported := SupportsInterface(context.Background(), backend, test.address, test.id)
+		assert.Equal(t, test.supported, supported, fmt.Sprintf("incorrect result at test %d", i))
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// nonceLockRetry is the interval between attempts to obtain the lock.
+var nonceLockRetry = 10 * time.Millisecond
+
+// NonceStore is a persistent store of the nonces handed out for an address on
+// a chain.  It is shared between concurrent processes by means of an OS lock
+// on a lock file, so that each process obtains a different nonce even if the
+// node has yet to see transactions from the others.  The lock is released by
+// the OS if a process exits without unlocking, so it is never left stale.
+type NonceStore struct {
+	path     string
+	lockPath string
+	lockFile *os.File
+	// Expiry is the time after which reservations that have not been seen by
+	// the node are assumed to have been abandoned, allowing the nonce to be
+	// reconciled with the node.
+	Expiry time.Duration
+	// LockTimeout is the maximum time to wait to obtain the lock.
+	LockTimeout time.Duration
+}
+
+type nonceState struct {
+	Next    uint64    `json:"next"`
+	Updated time.Time `json:"updated"`
+}
+
+// NewNonceStore creates a nonce store for the given chain and address in the
+// supplied directory.
+func NewNonceStore(dir string, chainID *big.Int, address common.Address) (*NonceStore, error) {
+	if chainID == nil {
+		return nil, errors.New("chain ID required")
+	}
+	storeDir := filepath.Join(dir, "nonces", chainID.String())
+	if err := os.MkdirAll(storeDir, 0700); err != nil {
+		return nil, err
+	}
+	path := filepath.Join(storeDir, fmt.Sprintf("%s.json", address.Hex()))
+	return &NonceStore{
+		path:        path,
+		lockPath:    path + ".lock",
+		Expiry:      10 * time.Minute,
+		LockTimeout: 10 * time.Second,
+	}, nil
+}
+
+// Reserve reserves count consecutive nonces, returning the first of them.
+// chainNonce is the next nonce according to the node, including pending
+// transactions; the store will never hand out a nonce lower than this.
+func (s *NonceStore) Reserve(chainNonce uint64, count uint64) (uint64, error) {
+	if count == 0 {
+		return 0, errors.New("count must be at least 1")
+	}
+	var first uint64
+	err := s.update(func(state *nonceState) bool {
+		first = s.reconcile(state, chainNonce)
+		state.Next = first + count
+		return true
+	})
+	return first, err
+}
+
+// Peek returns the next nonce that would be reserved, without reserving it.
+func (s *NonceStore) Peek(chainNonce uint64) (uint64, error) {
+	var next uint64
+	err := s.update(func(state *nonceState) bool {
+		next = s.reconcile(state, chainNonce)
+		return false
+	})
+	return next, err
+}
+
+// Release returns a reserved nonce to the store.  This only has an effect if
+// the nonce is the most recently reserved, otherwise it is left as a gap.
+func (s *NonceStore) Release(nonce uint64) error {
+	return s.update(func(state *nonceState) bool {
+		if state.Next != nonce+1 {
+			return false
+		}
+		state.Next = nonce
+		return true
+	})
+}
+
+// Reset sets the next nonce to be handed out, discarding all reservations.
+func (s *NonceStore) Reset(next uint64) error {
+	return s.update(func(state *nonceState) bool {
+		state.Next = next
+		return true
+	})
+}
+
+// reconcile returns the next nonce to hand out given the state and the node's
+// view of the next nonce.
+func (s *NonceStore) reconcile(state *nonceState, chainNonce uint64) uint64 {
+	if chainNonce >= state.Next {
+		return chainNonce
+	}
+	if s.Expiry > 0 && time.Since(state.Updated) > s.Expiry {
+		// Reservations the node has not seen have been abandoned
+		return chainNonce
+	}
+	return state.Next
+}
+
+// update reads the state under lock, calls the supplied function and writes
+// the state if the function returns true.
+func (s *NonceStore) update(fn func(state *nonceState) bool) error {
+	if err := s.lock(); err != nil {
+		return err
+	}
+	defer s.unlock()
+
+	state := &nonceState{}
+	data, err := ioutil.ReadFile(s.path)
+	if err == nil {
+		if err := json.Unmarshal(data, state); err != nil {
+			return fmt.Errorf("invalid nonce store %s: %v", s.path, err)
+		}
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	if !fn(state) {
+		return nil
+	}
+
+	state.Updated = time.Now()
+	data, err = json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	tmpPath := s.path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, s.path)
+}
+
+// lock obtains the lock for the store.
+func (s *NonceStore) lock() error {
+	file, err := os.OpenFile(s.lockPath, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	for {
+		locked, err := tryLockFile(file)
+		if err != nil {
+			file.Close()
+			return err
+		}
+		if locked {
+			s.lockFile = file
+			return nil
+		}
+		if time.Since(start) > s.LockTimeout {
+			file.Close()
+			return fmt.Errorf("timed out waiting for nonce store lock %s", s.lockPath)
+		}
+		time.Sleep(nonceLockRetry)
+	}
+}
+
+// unlock releases the lock for the store.  The lock file itself is left in
+// place, as removing it would allow another process to lock a new file while
+// a third still holds the lock on the old one.
+func (s *NonceStore) unlock() {
+	unlockFile(s.lockFile)
+	s.lockFile.Close()
+	s.lockFile = nil
+}
+
+// NonceGaps returns the nonces from first up to but not including next that
+// are not present in the supplied set of used nonces, along with any nonces
+// below the highest used nonce.
+func NonceGaps(first uint64, next uint64, used map[uint64]bool) []uint64 {
+	for nonce := range used {
+		if nonce >= next {
+			next = nonce + 1
+		}
+	}
+	gaps := make([]uint64, 0)
+	for nonce := first; nonce < next; nonce++ {
+		if !used[nonce] {
+			gaps = append(gaps, nonce)
+		}
+	}
+	return gaps
+}
@@ -0,0 +1,190 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var nonceStoreAddress = common.HexToAddress("0x5FfC014343cd971B7eb70732021E26C35B744cc4")
+
+func TestNonceStoreReserve(t *testing.T) {
+	dir, err := ioutil.TempDir("", "noncestore")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	store, err := NewNonceStore(dir, big.NewInt(1), nonceStoreAddress)
+	require.Nil(t, err)
+
+	// Chain nonce used when store is empty
+	nonce, err := store.Reserve(5, 1)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(5), nonce)
+
+	// Store used when node lags
+	nonce, err = store.Reserve(5, 3)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(6), nonce)
+	nonce, err = store.Peek(5)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(9), nonce)
+
+	// Chain nonce used when node is ahead
+	nonce, err = store.Reserve(20, 1)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(20), nonce)
+
+	// Release of most recent reservation
+	require.Nil(t, store.Release(20))
+	nonce, err = store.Peek(0)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(20), nonce)
+
+	// Release of earlier reservation has no effect
+	require.Nil(t, store.Release(6))
+	nonce, err = store.Peek(0)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(20), nonce)
+
+	// Separate chains are separate
+	other, err := NewNonceStore(dir, big.NewInt(5), nonceStoreAddress)
+	require.Nil(t, err)
+	nonce, err = other.Peek(0)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(0), nonce)
+
+	// Reset
+	require.Nil(t, store.Reset(7))
+	nonce, err = store.Reserve(0, 1)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(7), nonce)
+
+	// Expired reservations reconcile with the chain
+	store.Expiry = time.Nanosecond
+	time.Sleep(time.Millisecond)
+	nonce, err = store.Reserve(3, 1)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(3), nonce)
+}
+
+func TestNonceStoreConcurrent(t *testing.T) {
+	dir, err := ioutil.TempDir("", "noncestore")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	workers := 10
+	perWorker := 20
+	var mu sync.Mutex
+	seen := make(map[uint64]bool)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Each worker has its own store, as would separate processes
+			store, err := NewNonceStore(dir, big.NewInt(1), nonceStoreAddress)
+			require.Nil(t, err)
+			for j := 0; j < perWorker; j++ {
+				nonce, err := store.Reserve(0, 1)
+				require.Nil(t, err)
+				mu.Lock()
+				assert.False(t, seen[nonce], "duplicate nonce %d", nonce)
+				seen[nonce] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Len(t, seen, workers*perWorker)
+}
+
+func TestNonceStoreLock(t *testing.T) {
+	dir, err := ioutil.TempDir("", "noncestore")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	store, err := NewNonceStore(dir, big.NewInt(1), nonceStoreAddress)
+	require.Nil(t, err)
+
+	// Lock file left behind by an exited process
+	require.Nil(t, ioutil.WriteFile(store.lockPath, []byte{}, 0600))
+	nonce, err := store.Reserve(1, 1)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(1), nonce)
+
+	// Lock held by a live process, regardless of the age of the lock file
+	other, err := NewNonceStore(dir, big.NewInt(1), nonceStoreAddress)
+	require.Nil(t, err)
+	require.Nil(t, other.lock())
+	old := time.Now().Add(-time.Hour)
+	require.Nil(t, os.Chtimes(store.lockPath, old, old))
+	store.LockTimeout = 50 * time.Millisecond
+	_, err = store.Reserve(1, 1)
+	assert.NotNil(t, err)
+
+	// Released lock
+	other.unlock()
+	nonce, err = store.Reserve(1, 1)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(2), nonce)
+}
+
+func TestNonceGaps(t *testing.T) {
+	tests := []struct {
+		first uint64
+		next  uint64
+		used  map[uint64]bool
+		gaps  []uint64
+	}{
+		{ // 0 - nothing outstanding
+			first: 5,
+			next:  5,
+			used:  map[uint64]bool{},
+			gaps:  []uint64{},
+		},
+		{ // 1 - all outstanding in pool
+			first: 5,
+			next:  8,
+			used:  map[uint64]bool{5: true, 6: true, 7: true},
+			gaps:  []uint64{},
+		},
+		{ // 2 - reserved but not in pool
+			first: 5,
+			next:  8,
+			used:  map[uint64]bool{5: true},
+			gaps:  []uint64{6, 7},
+		},
+		{ // 3 - queued beyond next
+			first: 5,
+			next:  5,
+			used:  map[uint64]bool{7: true, 9: true},
+			gaps:  []uint64{5, 6, 8},
+		},
+	}
+
+	for i, tt := range tests {
+		gaps := NonceGaps(tt.first, tt.next, tt.used)
+		assert.Equal(t, tt.gaps, gaps, fmt.Sprintf("incorrect gaps at test %d", i))
+	}
+}
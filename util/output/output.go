@@ -0,0 +1,183 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package output provides structured output of the results of commands.
+package output
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Format is the format in which results are output.
+type Format int
+
+const (
+	// FormatText is the free-form text output of each command.
+	FormatText Format = iota
+	// FormatJSON is JSON.
+	FormatJSON
+	// FormatYAML is YAML.
+	FormatYAML
+	// FormatTable is a table of fields and values.
+	FormatTable
+)
+
+var formatNames = map[Format]string{
+	FormatText:  "text",
+	FormatJSON:  "json",
+	FormatYAML:  "yaml",
+	FormatTable: "table",
+}
+
+// String returns the name of the format.
+func (f Format) String() string {
+	if name, exists := formatNames[f]; exists {
+		return name
+	}
+	return "unknown"
+}
+
+// ParseFormat parses the name of an output format.
+func ParseFormat(input string) (Format, error) {
+	switch strings.ToLower(input) {
+	case "", "text":
+		return FormatText, nil
+	case "json":
+		return FormatJSON, nil
+	case "yaml":
+		return FormatYAML, nil
+	case "table":
+		return FormatTable, nil
+	default:
+		return FormatText, fmt.Errorf("unknown output format %s", input)
+	}
+}
+
+// Write writes a result in the given format.
+func Write(w io.Writer, format Format, result interface{}) error {
+	var data []byte
+	var err error
+	switch format {
+	case FormatJSON:
+		data, err = json.Marshal(result)
+		data = append(data, '\n')
+	case FormatYAML:
+		data, err = yaml.Marshal(result)
+	case FormatTable:
+		return writeTable(w, result)
+	default:
+		return fmt.Errorf("format %v is not structured", format)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// writeTable writes a result as a table of fields and values.  Nested values
+// are flattened, for example the first element of the array field 'outputs'
+// becomes 'outputs[0]'.
+func writeTable(w io.Writer, result interface{}) error {
+	rows, err := flatten("", reflect.ValueOf(result))
+	if err != nil {
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	for _, row := range rows {
+		fmt.Fprintf(tw, "%s\t%s\n", row[0], row[1])
+	}
+	return tw.Flush()
+}
+
+// tableValueReplacer replaces the characters in values that would break the
+// alignment of a table.
+var tableValueReplacer = strings.NewReplacer("\t", " ", "\r", " ", "\n", " ")
+
+// flatten turns a value in to a list of name/value pairs.
+func flatten(prefix string, value reflect.Value) ([][2]string, error) {
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return nil, nil
+		}
+		value = value.Elem()
+	}
+
+	rows := make([][2]string, 0)
+	switch value.Kind() {
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			name, omitEmpty := jsonName(field)
+			if name == "" || (omitEmpty && value.Field(i).IsZero()) {
+				continue
+			}
+			if prefix != "" {
+				name = fmt.Sprintf("%s.%s", prefix, name)
+			}
+			fieldRows, err := flatten(name, value.Field(i))
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, fieldRows...)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			elemRows, err := flatten(fmt.Sprintf("%s[%d]", prefix, i), value.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, elemRows...)
+		}
+	case reflect.Float32, reflect.Float64:
+		rows = append(rows, [2]string{prefix, strconv.FormatFloat(value.Float(), 'f', -1, 64)})
+	case reflect.Map, reflect.Func, reflect.Chan:
+		return nil, errors.New("unsupported value for table")
+	default:
+		rows = append(rows, [2]string{prefix, tableValueReplacer.Replace(fmt.Sprintf("%v", value.Interface()))})
+	}
+	return rows, nil
+}
+
+// jsonName obtains the JSON name of a struct field, and if it is omitted when empty.
+func jsonName(field reflect.StructField) (string, bool) {
+	if field.PkgPath != "" {
+		// Unexported
+		return "", false
+	}
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+	parts := strings.Split(tag, ",")
+	name := parts[0]
+	if name == "" {
+		name = field.Name
+	}
+	omitEmpty := false
+	for _, part := range parts[1:] {
+		if part == "omitempty" {
+			omitEmpty = true
+		}
+	}
+	return name, omitEmpty
+}
@@ -0,0 +1,400 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		input  string
+		output Format
+		err    bool
+	}{
+		{input: "", output: FormatText},
+		{input: "text", output: FormatText},
+		{input: "json", output: FormatJSON},
+		{input: "JSON", output: FormatJSON},
+		{input: "yaml", output: FormatYAML},
+		{input: "table", output: FormatTable},
+		{input: "xml", err: true},
+	}
+
+	for i, test := range tests {
+		output, err := ParseFormat(test.input)
+		if test.err {
+			assert.NotNil(t, err, fmt.Sprintf("missing expected error at test %d", i))
+			continue
+		}
+		require.Nil(t, err, fmt.Sprintf("unexpected error at test %d", i))
+		assert.Equal(t, test.output, output, fmt.Sprintf("incorrect format at test %d", i))
+	}
+}
+
+func TestWrite(t *testing.T) {
+	tests := []struct {
+		result interface{}
+		json   string
+		yaml   string
+		table  string
+	}{
+		{ // 0 - block info
+			result: &BlockInfo{
+				Number:        12345,
+				Hash:          "0x01",
+				ParentHash:    "0x02",
+				Timestamp:     1600000000,
+				Miner:         "0x03",
+				Extra:         "0x",
+				Difficulty:    "2",
+				GasLimit:      30000000,
+				GasUsed:       15000000,
+				BaseFeePerGas: "1000000000",
+				Uncles:        []string{},
+				Transactions:  []string{"0x04", "0x05"},
+			},
+			json: `{"number":12345,"hash":"0x01","parentHash":"0x02","timestamp":1600000000,"miner":"0x03","extra":"0x","difficulty":"2","gasLimit":30000000,"gasUsed":15000000,"baseFeePerGas":"1000000000","uncles":[],"transactions":["0x04","0x05"]}
+`,
+			yaml: `number: 12345
+hash: "0x01"
+parentHash: "0x02"
+timestamp: 1600000000
+miner: "0x03"
+extra: 0x
+difficulty: "2"
+gasLimit: 30000000
+gasUsed: 15000000
+baseFeePerGas: "1000000000"
+uncles: []
+transactions:
+- "0x04"
+- "0x05"
+`,
+			table: `number           12345
+hash             0x01
+parentHash       0x02
+timestamp        1600000000
+miner            0x03
+extra            0x
+difficulty       2
+gasLimit         30000000
+gasUsed          15000000
+baseFeePerGas    1000000000
+transactions[0]  0x04
+transactions[1]  0x05
+`,
+		},
+		{ // 1 - ens info
+			result: &ENSInfo{
+				Domain:     "wealdtech.eth",
+				NameHash:   "0x01",
+				LabelHash:  "0x02",
+				Registrar:  "permanent",
+				Registrant: "0x03",
+				Expiry:     1700000000,
+				Controller: "0x03",
+				Resolver:   "0x04",
+				Address:    "0x05",
+			},
+			json: `{"domain":"wealdtech.eth","nameHash":"0x01","labelHash":"0x02","registrar":"permanent","registrant":"0x03","expiry":1700000000,"controller":"0x03","resolver":"0x04","address":"0x05"}
+`,
+			yaml: `domain: wealdtech.eth
+nameHash: "0x01"
+labelHash: "0x02"
+registrar: permanent
+registrant: "0x03"
+expiry: 1700000000
+controller: "0x03"
+resolver: "0x04"
+address: "0x05"
+`,
+			table: `domain      wealdtech.eth
+nameHash    0x01
+labelHash   0x02
+registrar   permanent
+registrant  0x03
+expiry      1700000000
+controller  0x03
+resolver    0x04
+address     0x05
+`,
+		},
+		{ // 2 - token info
+			result: &TokenInfo{Address: "0x01", Name: "Token", Symbol: "TKN", Decimals: 18, TotalSupply: "1000000000000000000000"},
+			json: `{"address":"0x01","name":"Token","symbol":"TKN","decimals":18,"totalSupply":"1000000000000000000000"}
+`,
+			yaml: `address: "0x01"
+name: Token
+symbol: TKN
+decimals: 18
+totalSupply: "1000000000000000000000"
+`,
+			table: `address      0x01
+name         Token
+symbol       TKN
+decimals     18
+totalSupply  1000000000000000000000
+`,
+		},
+		{ // 3 - ether balance
+			result: &EtherBalance{Address: "0x01", Balance: "1500000000000000000"},
+			json: `{"address":"0x01","balance":"1500000000000000000"}
+`,
+			yaml: `address: "0x01"
+balance: "1500000000000000000"
+`,
+			table: `address  0x01
+balance  1500000000000000000
+`,
+		},
+		{ // 4 - gas price
+			result: &GasPrice{Blocks: 5, GasPrice: "20000000000"},
+			json: `{"blocks":5,"gasPrice":"20000000000"}
+`,
+			yaml: `blocks: 5
+gasPrice: "20000000000"
+`,
+			table: `blocks    5
+gasPrice  20000000000
+`,
+		},
+		{ // 5 - network blocktime
+			result: &NetworkBlockTime{FromBlock: 100, ToBlock: 172, BlockTime: 13.2},
+			json: `{"fromBlock":100,"toBlock":172,"blockTime":13.2}
+`,
+			yaml: `fromBlock: 100
+toBlock: 172
+blockTime: 13.2
+`,
+			table: `fromBlock  100
+toBlock    172
+blockTime  13.2
+`,
+		},
+		{ // 6 - network gps
+			result: &NetworkGPS{Blocks: 5, Gas: 75000000, Duration: 60, GasPerSecond: 1250000},
+			json: `{"blocks":5,"gas":75000000,"duration":60,"gasPerSecond":1250000}
+`,
+			yaml: `blocks: 5
+gas: 75000000
+duration: 60
+gasPerSecond: 1250000
+`,
+			table: `blocks        5
+gas           75000000
+duration      60
+gasPerSecond  1250000
+`,
+		},
+		{ // 7 - network tps
+			result: &NetworkTPS{Blocks: 5, Transactions: 900, Duration: 60, TransactionsPerSecond: 15},
+			json: `{"blocks":5,"transactions":900,"duration":60,"transactionsPerSecond":15}
+`,
+			yaml: `blocks: 5
+transactions: 900
+duration: 60
+transactionsPerSecond: 15
+`,
+			table: `blocks                 5
+transactions           900
+duration               60
+transactionsPerSecond  15
+`,
+		},
+		{ // 8 - network usage
+			result: &NetworkUsage{Blocks: 5, GasUsed: 75000000, GasLimit: 150000000, Usage: 50},
+			json: `{"blocks":5,"gasUsed":75000000,"gasLimit":150000000,"usage":50}
+`,
+			yaml: `blocks: 5
+gasUsed: 75000000
+gasLimit: 150000000
+usage: 50
+`,
+			table: `blocks    5
+gasUsed   75000000
+gasLimit  150000000
+usage     50
+`,
+		},
+		{ // 9 - contract call
+			result: &ContractCall{
+				Contract: "0x01",
+				Method:   "getReserves",
+				Outputs: []*ContractCallOutput{
+					{Name: "reserve0", Type: "uint112", Value: "100"},
+					{Type: "uint32", Value: "200"},
+				},
+			},
+			json: `{"contract":"0x01","method":"getReserves","outputs":[{"name":"reserve0","type":"uint112","value":"100"},{"type":"uint32","value":"200"}]}
+`,
+			yaml: `contract: "0x01"
+method: getReserves
+outputs:
+- name: reserve0
+  type: uint112
+  value: "100"
+- type: uint32
+  value: "200"
+`,
+			table: `contract          0x01
+method            getReserves
+outputs[0].name   reserve0
+outputs[0].type   uint112
+outputs[0].value  100
+outputs[1].type   uint32
+outputs[1].value  200
+`,
+		},
+		{ // 10 - dns get
+			result: &DNSGet{Domain: "wealdtech.eth.", Name: "www.wealdtech.eth.", Resource: "A", Records: []string{"www.wealdtech.eth.\t3600\tIN\tA\t193.62.81.1"}, Wire: "01"},
+			json: `{"domain":"wealdtech.eth.","name":"www.wealdtech.eth.","resource":"A","records":["www.wealdtech.eth.\t3600\tIN\tA\t193.62.81.1"],"wire":"01"}
+`,
+			yaml: `domain: wealdtech.eth.
+name: www.wealdtech.eth.
+resource: A
+records:
+- "www.wealdtech.eth.\t3600\tIN\tA\t193.62.81.1"
+wire: "01"
+`,
+			table: `domain      wealdtech.eth.
+name        www.wealdtech.eth.
+resource    A
+records[0]  www.wealdtech.eth. 3600 IN A 193.62.81.1
+wire        01
+`,
+		},
+		{ // 11 - registry implementer
+			result: &RegistryImplementer{Address: "0x01", Interface: "ERC777Token", Implementer: "0x02"},
+			json: `{"address":"0x01","interface":"ERC777Token","implementer":"0x02"}
+`,
+			yaml: `address: "0x01"
+interface: ERC777Token
+implementer: "0x02"
+`,
+			table: `address      0x01
+interface    ERC777Token
+implementer  0x02
+`,
+		},
+		{ // 12 - registry implements
+			result: &RegistryImplements{Address: "0x01", Interface: "ERC777Token", Implements: true},
+			json: `{"address":"0x01","interface":"ERC777Token","implements":true}
+`,
+			yaml: `address: "0x01"
+interface: ERC777Token
+implements: true
+`,
+			table: `address     0x01
+interface   ERC777Token
+implements  true
+`,
+		},
+		{ // 13 - registry manager
+			result: &RegistryManager{Address: "0x01", Manager: "0x02"},
+			json: `{"address":"0x01","manager":"0x02"}
+`,
+			yaml: `address: "0x01"
+manager: "0x02"
+`,
+			table: `address  0x01
+manager  0x02
+`,
+		},
+		{ // 14 - contract events
+			result: &ContractEvents{
+				Events: []*ContractEvent{
+					{
+						BlockNumber:     100,
+						TransactionHash: "0x01",
+						LogIndex:        2,
+						Address:         "0x03",
+						Name:            "Transfer",
+						Args: []*ContractEventArg{
+							{Name: "from", Type: "address", Indexed: true, Value: "0x04"},
+							{Name: "value", Type: "uint256", Value: "5"},
+						},
+						Topics: []string{"0x06", "0x04"},
+						Data:   "0x05",
+					},
+				},
+			},
+			json: `{"events":[{"blockNumber":100,"transactionHash":"0x01","logIndex":2,"address":"0x03","name":"Transfer","args":[{"name":"from","type":"address","indexed":true,"value":"0x04"},{"name":"value","type":"uint256","indexed":false,"value":"5"}],"topics":["0x06","0x04"],"data":"0x05"}]}
+`,
+			yaml: `events:
+- blockNumber: 100
+  transactionHash: "0x01"
+  logIndex: 2
+  address: "0x03"
+  name: Transfer
+  args:
+  - name: from
+    type: address
+    indexed: true
+    value: "0x04"
+  - name: value
+    type: uint256
+    indexed: false
+    value: "5"
+  topics:
+  - "0x06"
+  - "0x04"
+  data: "0x05"
+`,
+			table: `events[0].blockNumber      100
+events[0].transactionHash  0x01
+events[0].logIndex         2
+events[0].address          0x03
+events[0].name             Transfer
+events[0].args[0].name     from
+events[0].args[0].type     address
+events[0].args[0].indexed  true
+events[0].args[0].value    0x04
+events[0].args[1].name     value
+events[0].args[1].type     uint256
+events[0].args[1].indexed  false
+events[0].args[1].value    5
+events[0].topics[0]        0x06
+events[0].topics[1]        0x04
+events[0].data             0x05
+`,
+		},
+	}
+
+	for i, test := range tests {
+		buf := new(bytes.Buffer)
+		require.Nil(t, Write(buf, FormatJSON, test.result), fmt.Sprintf("failed to write JSON at test %d", i))
+		assert.Equal(t, test.json, buf.String(), fmt.Sprintf("incorrect JSON at test %d", i))
+
+		buf.Reset()
+		require.Nil(t, Write(buf, FormatYAML, test.result), fmt.Sprintf("failed to write YAML at test %d", i))
+		assert.Equal(t, test.yaml, buf.String(), fmt.Sprintf("incorrect YAML at test %d", i))
+
+		if test.table != "" {
+			buf.Reset()
+			require.Nil(t, Write(buf, FormatTable, test.result), fmt.Sprintf("failed to write table at test %d", i))
+			assert.Equal(t, test.table, buf.String(), fmt.Sprintf("incorrect table at test %d", i))
+		}
+	}
+}
+
+func TestWriteText(t *testing.T) {
+	err := Write(new(bytes.Buffer), FormatText, &GasPrice{})
+	assert.NotNil(t, err)
+}
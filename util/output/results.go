@@ -0,0 +1,191 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+// The field names of the results below form part of ethereal's interface,
+// so should not be changed once released.  Values that can exceed 64 bits,
+// such as amounts of Wei, are decimal strings.
+
+// BlockInfo is the result of 'block info'.
+type BlockInfo struct {
+	Number        uint64   `json:"number" yaml:"number"`
+	Hash          string   `json:"hash" yaml:"hash"`
+	ParentHash    string   `json:"parentHash" yaml:"parentHash"`
+	Timestamp     uint64   `json:"timestamp" yaml:"timestamp"`
+	Miner         string   `json:"miner" yaml:"miner"`
+	Extra         string   `json:"extra" yaml:"extra"`
+	Difficulty    string   `json:"difficulty" yaml:"difficulty"`
+	GasLimit      uint64   `json:"gasLimit" yaml:"gasLimit"`
+	GasUsed       uint64   `json:"gasUsed" yaml:"gasUsed"`
+	BaseFeePerGas string   `json:"baseFeePerGas,omitempty" yaml:"baseFeePerGas,omitempty"`
+	Uncles        []string `json:"uncles" yaml:"uncles"`
+	Transactions  []string `json:"transactions" yaml:"transactions"`
+}
+
+// ENSInfo is the result of 'ens info'.
+type ENSInfo struct {
+	Domain      string `json:"domain" yaml:"domain"`
+	NameHash    string `json:"nameHash" yaml:"nameHash"`
+	LabelHash   string `json:"labelHash" yaml:"labelHash"`
+	Registrar   string `json:"registrar,omitempty" yaml:"registrar,omitempty"`
+	Registrant  string `json:"registrant,omitempty" yaml:"registrant,omitempty"`
+	Expiry      uint64 `json:"expiry,omitempty" yaml:"expiry,omitempty"`
+	Controller  string `json:"controller,omitempty" yaml:"controller,omitempty"`
+	Resolver    string `json:"resolver,omitempty" yaml:"resolver,omitempty"`
+	Address     string `json:"address,omitempty" yaml:"address,omitempty"`
+	ReverseName string `json:"reverseName,omitempty" yaml:"reverseName,omitempty"`
+	ContentHash string `json:"contentHash,omitempty" yaml:"contentHash,omitempty"`
+}
+
+// TokenInfo is the result of 'token info'.
+type TokenInfo struct {
+	Address     string `json:"address" yaml:"address"`
+	Name        string `json:"name" yaml:"name"`
+	Symbol      string `json:"symbol" yaml:"symbol"`
+	Decimals    uint8  `json:"decimals" yaml:"decimals"`
+	TotalSupply string `json:"totalSupply" yaml:"totalSupply"`
+}
+
+// EtherBalance is the result of 'ether balance'.
+type EtherBalance struct {
+	Address string `json:"address" yaml:"address"`
+	Block   string `json:"block,omitempty" yaml:"block,omitempty"`
+	Balance string `json:"balance" yaml:"balance"`
+}
+
+// GasPrice is the result of 'gas price'.
+type GasPrice struct {
+	Blocks   int64  `json:"blocks" yaml:"blocks"`
+	GasPrice string `json:"gasPrice" yaml:"gasPrice"`
+}
+
+// NetworkBlockTime is the result of 'network blocktime'.
+type NetworkBlockTime struct {
+	FromBlock uint64  `json:"fromBlock" yaml:"fromBlock"`
+	ToBlock   uint64  `json:"toBlock" yaml:"toBlock"`
+	BlockTime float64 `json:"blockTime" yaml:"blockTime"`
+}
+
+// NetworkGPS is the result of 'network gps'.
+type NetworkGPS struct {
+	Blocks       int64   `json:"blocks" yaml:"blocks"`
+	Gas          uint64  `json:"gas" yaml:"gas"`
+	Duration     float64 `json:"duration" yaml:"duration"`
+	GasPerSecond uint64  `json:"gasPerSecond" yaml:"gasPerSecond"`
+}
+
+// NetworkTPS is the result of 'network tps'.
+type NetworkTPS struct {
+	Blocks                int64   `json:"blocks" yaml:"blocks"`
+	Transactions          int     `json:"transactions" yaml:"transactions"`
+	Duration              float64 `json:"duration" yaml:"duration"`
+	TransactionsPerSecond float64 `json:"transactionsPerSecond" yaml:"transactionsPerSecond"`
+}
+
+// NetworkUsage is the result of 'network usage'.
+type NetworkUsage struct {
+	Blocks   int64   `json:"blocks" yaml:"blocks"`
+	GasUsed  uint64  `json:"gasUsed" yaml:"gasUsed"`
+	GasLimit uint64  `json:"gasLimit" yaml:"gasLimit"`
+	Usage    float64 `json:"usage" yaml:"usage"`
+}
+
+// ContractCall is the result of 'contract call'.
+type ContractCall struct {
+	Contract string                `json:"contract" yaml:"contract"`
+	Method   string                `json:"method" yaml:"method"`
+	Outputs  []*ContractCallOutput `json:"outputs" yaml:"outputs"`
+}
+
+// ContractCallOutput is a single output of a contract call.
+type ContractCallOutput struct {
+	Name  string `json:"name,omitempty" yaml:"name,omitempty"`
+	Type  string `json:"type" yaml:"type"`
+	Value string `json:"value" yaml:"value"`
+}
+
+// DNSGet is the result of 'dns get'.
+type DNSGet struct {
+	Domain   string   `json:"domain" yaml:"domain"`
+	Name     string   `json:"name" yaml:"name"`
+	Resource string   `json:"resource" yaml:"resource"`
+	Records  []string `json:"records" yaml:"records"`
+	Wire     string   `json:"wire" yaml:"wire"`
+}
+
+// RegistryImplementer is the result of 'registry implementer get'.
+type RegistryImplementer struct {
+	Address     string `json:"address" yaml:"address"`
+	Interface   string `json:"interface" yaml:"interface"`
+	Implementer string `json:"implementer" yaml:"implementer"`
+}
+
+// RegistryImplements is the result of 'registry implements'.
+type RegistryImplements struct {
+	Address    string `json:"address" yaml:"address"`
+	Interface  string `json:"interface" yaml:"interface"`
+	Implements bool   `json:"implements" yaml:"implements"`
+}
+
+// RegistryManager is the result of 'registry manager get'.
+type RegistryManager struct {
+	Address string `json:"address" yaml:"address"`
+	Manager string `json:"manager" yaml:"manager"`
+}
+
+// ContractEvents is the result of 'contract events'.
+type ContractEvents struct {
+	Events []*ContractEvent `json:"events" yaml:"events"`
+}
+
+// ContractEvent is a single event emitted by a contract.  Name and Args are
+// only present if the event could be decoded.
+type ContractEvent struct {
+	BlockNumber     uint64              `json:"blockNumber" yaml:"blockNumber"`
+	TransactionHash string              `json:"transactionHash" yaml:"transactionHash"`
+	LogIndex        uint                `json:"logIndex" yaml:"logIndex"`
+	Address         string              `json:"address" yaml:"address"`
+	Name            string              `json:"name,omitempty" yaml:"name,omitempty"`
+	Args            []*ContractEventArg `json:"args,omitempty" yaml:"args,omitempty"`
+	Topics          []string            `json:"topics" yaml:"topics"`
+	Data            string              `json:"data" yaml:"data"`
+}
+
+// ContractEventArg is a single argument of a contract event.
+type ContractEventArg struct {
+	Name    string `json:"name,omitempty" yaml:"name,omitempty"`
+	Type    string `json:"type" yaml:"type"`
+	Indexed bool   `json:"indexed" yaml:"indexed"`
+	Value   string `json:"value" yaml:"value"`
+}
+
+// SafeInfo is the result of 'safe info'.
+type SafeInfo struct {
+	Address   string   `json:"address" yaml:"address"`
+	Version   string   `json:"version" yaml:"version"`
+	Owners    []string `json:"owners" yaml:"owners"`
+	Threshold uint64   `json:"threshold" yaml:"threshold"`
+	Nonce     string   `json:"nonce" yaml:"nonce"`
+}
+
+// NFTInfo is the result of 'nft info'.
+type NFTInfo struct {
+	Address     string `json:"address" yaml:"address"`
+	Name        string `json:"name" yaml:"name"`
+	Symbol      string `json:"symbol" yaml:"symbol"`
+	TotalSupply string `json:"totalSupply,omitempty" yaml:"totalSupply,omitempty"`
+	ERC721      bool   `json:"erc721" yaml:"erc721"`
+	Metadata    bool   `json:"metadata" yaml:"metadata"`
+	Enumerable  bool   `json:"enumerable" yaml:"enumerable"`
+}
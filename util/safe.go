@@ -0,0 +1,506 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/wealdtech/ethereal/util/contracts"
+)
+
+// Operations that a Safe can carry out.
+const (
+	SafeOperationCall         uint8 = 0
+	SafeOperationDelegateCall uint8 = 1
+)
+
+// MultiSendCallOnlyAddress is the address at which the MultiSendCallOnly
+// contract is deployed on most chains.  A Safe delegate calls it to carry out
+// a batch of calls.
+var MultiSendCallOnlyAddress = common.HexToAddress("0x40A2aCCbd92BCA938b02010E17A5b8929b49130D")
+
+// safeTxFields are the fields of the SafeTx type signed by the owners of a Safe.
+var safeTxFields = []apitypes.Type{
+	{Name: "to", Type: "address"},
+	{Name: "value", Type: "uint256"},
+	{Name: "data", Type: "bytes"},
+	{Name: "operation", Type: "uint8"},
+	{Name: "safeTxGas", Type: "uint256"},
+	{Name: "baseGas", Type: "uint256"},
+	{Name: "gasPrice", Type: "uint256"},
+	{Name: "gasToken", Type: "address"},
+	{Name: "refundReceiver", Type: "address"},
+	{Name: "nonce", Type: "uint256"},
+}
+
+// SafeCall is a single call in a batch carried out with MultiSend.
+type SafeCall struct {
+	To    common.Address
+	Value *big.Int
+	Data  []byte
+}
+
+// SafeSignature is the signature of a Safe transaction by one of its owners.
+type SafeSignature struct {
+	Signer    common.Address
+	Signature []byte
+}
+
+// SafeTransaction is a transaction to be executed by a Gnosis Safe, along
+// with the signatures of its owners.
+type SafeTransaction struct {
+	Safe           common.Address
+	ChainID        *big.Int
+	Version        string
+	To             common.Address
+	Value          *big.Int
+	Data           []byte
+	Operation      uint8
+	SafeTxGas      *big.Int
+	BaseGas        *big.Int
+	GasPrice       *big.Int
+	GasToken       common.Address
+	RefundReceiver common.Address
+	Nonce          *big.Int
+	// Signatures are kept in ascending order of signer, as required by the Safe.
+	Signatures []*SafeSignature
+}
+
+// NewSafeTransaction creates a Safe transaction with no gas refund.
+func NewSafeTransaction(safe common.Address, chainID *big.Int, version string, nonce *big.Int, to common.Address, value *big.Int, data []byte, operation uint8) *SafeTransaction {
+	return &SafeTransaction{
+		Safe:       safe,
+		ChainID:    chainID,
+		Version:    version,
+		To:         to,
+		Value:      value,
+		Data:       data,
+		Operation:  operation,
+		SafeTxGas:  big.NewInt(0),
+		BaseGas:    big.NewInt(0),
+		GasPrice:   big.NewInt(0),
+		Nonce:      nonce,
+		Signatures: make([]*SafeSignature, 0),
+	}
+}
+
+// safeDomainHasChainID returns true if the EIP-712 domain of a Safe of the
+// given version includes the chain ID, which it does from version 1.3.0.
+func safeDomainHasChainID(version string) (bool, error) {
+	parts := strings.SplitN(strings.SplitN(version, "+", 2)[0], ".", 3)
+	if len(parts) < 2 {
+		return false, fmt.Errorf("invalid Safe version %q", version)
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return false, fmt.Errorf("invalid Safe version %q", version)
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return false, fmt.Errorf("invalid Safe version %q", version)
+	}
+	if major < 1 {
+		return false, fmt.Errorf("unsupported Safe version %s", version)
+	}
+	return major > 1 || minor >= 3, nil
+}
+
+// TypedData returns the EIP-712 typed data of the transaction signed by the
+// owners of the Safe.
+func (t *SafeTransaction) TypedData() (*apitypes.TypedData, error) {
+	hasChainID, err := safeDomainHasChainID(t.Version)
+	if err != nil {
+		return nil, err
+	}
+	domainFields := make([]apitypes.Type, 0, 2)
+	domain := apitypes.TypedDataDomain{}
+	if hasChainID {
+		if t.ChainID == nil {
+			return nil, errors.New("chain ID is required")
+		}
+		domainFields = append(domainFields, apitypes.Type{Name: "chainId", Type: "uint256"})
+		domain.ChainId = (*math.HexOrDecimal256)(new(big.Int).Set(t.ChainID))
+	}
+	domainFields = append(domainFields, apitypes.Type{Name: "verifyingContract", Type: "address"})
+	domain.VerifyingContract = t.Safe.Hex()
+
+	for name, val := range map[string]*big.Int{"value": t.Value, "safeTxGas": t.SafeTxGas, "baseGas": t.BaseGas, "gasPrice": t.GasPrice, "nonce": t.Nonce} {
+		if val == nil {
+			return nil, fmt.Errorf("%s is required", name)
+		}
+	}
+
+	return &apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": domainFields,
+			"SafeTx":       safeTxFields,
+		},
+		PrimaryType: "SafeTx",
+		Domain:      domain,
+		Message: map[string]interface{}{
+			"to":             t.To.Hex(),
+			"value":          t.Value.String(),
+			"data":           hexutil.Encode(t.Data),
+			"operation":      strconv.Itoa(int(t.Operation)),
+			"safeTxGas":      t.SafeTxGas.String(),
+			"baseGas":        t.BaseGas.String(),
+			"gasPrice":       t.GasPrice.String(),
+			"gasToken":       t.GasToken.Hex(),
+			"refundReceiver": t.RefundReceiver.Hex(),
+			"nonce":          t.Nonce.String(),
+		},
+	}, nil
+}
+
+// Hash returns the hash of the transaction signed by the owners of the Safe.
+func (t *SafeTransaction) Hash() (common.Hash, error) {
+	typedData, err := t.TypedData()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return TypedDataHash(typedData)
+}
+
+// AddSignature adds the signature of an owner to the transaction, replacing
+// any existing signature by the same owner.  The signature must be that of
+// the transaction's hash by the signer.
+func (t *SafeTransaction) AddSignature(signer common.Address, signature []byte) error {
+	if len(signature) != 65 {
+		return fmt.Errorf("invalid signature length %d", len(signature))
+	}
+	sig := make([]byte, 65)
+	copy(sig, signature)
+	// The Safe requires a V of 27 or 28 for signatures of the hash.
+	if sig[64] < 27 {
+		sig[64] += 27
+	}
+	if sig[64] != 27 && sig[64] != 28 {
+		return fmt.Errorf("unsupported signature type %d", sig[64])
+	}
+
+	hash, err := t.Hash()
+	if err != nil {
+		return err
+	}
+	recoverSig := append(append([]byte{}, sig[:64]...), sig[64]-27)
+	key, err := crypto.SigToPub(hash.Bytes(), recoverSig)
+	if err != nil {
+		return fmt.Errorf("invalid signature: %v", err)
+	}
+	if crypto.PubkeyToAddress(*key) != signer {
+		return fmt.Errorf("signature is not from %s", signer.Hex())
+	}
+
+	signatures := make([]*SafeSignature, 0, len(t.Signatures)+1)
+	for _, existing := range t.Signatures {
+		if existing.Signer != signer {
+			signatures = append(signatures, existing)
+		}
+	}
+	signatures = append(signatures, &SafeSignature{Signer: signer, Signature: sig})
+	sortSafeSignatures(signatures)
+	t.Signatures = signatures
+	return nil
+}
+
+// Merge adds the signatures of another copy of the same transaction.
+func (t *SafeTransaction) Merge(other *SafeTransaction) error {
+	hash, err := t.Hash()
+	if err != nil {
+		return err
+	}
+	otherHash, err := other.Hash()
+	if err != nil {
+		return err
+	}
+	if hash != otherHash {
+		return fmt.Errorf("transaction %s does not match %s", otherHash.Hex(), hash.Hex())
+	}
+	for _, signature := range other.Signatures {
+		if err := t.AddSignature(signature.Signer, signature.Signature); err != nil {
+			return fmt.Errorf("invalid signature from %s: %v", signature.Signer.Hex(), err)
+		}
+	}
+	return nil
+}
+
+// Signers returns the signers of the transaction that are owners of the Safe.
+func (t *SafeTransaction) Signers(owners []common.Address) []common.Address {
+	signers := make([]common.Address, 0, len(t.Signatures))
+	for _, signature := range t.Signatures {
+		for _, owner := range owners {
+			if signature.Signer == owner {
+				signers = append(signers, owner)
+				break
+			}
+		}
+	}
+	return signers
+}
+
+// EncodedSignatures returns the signatures in the form required by
+// execTransaction.  An owner in approvers that has not signed is added with
+// a signature that is valid if the owner is the sender of the transaction.
+func (t *SafeTransaction) EncodedSignatures(approvers ...common.Address) []byte {
+	signatures := make([]*SafeSignature, len(t.Signatures))
+	copy(signatures, t.Signatures)
+	for _, approver := range approvers {
+		signed := false
+		for _, signature := range signatures {
+			if signature.Signer == approver {
+				signed = true
+				break
+			}
+		}
+		if !signed {
+			// A V of 1 marks the owner in R as having approved the
+			// transaction by sending it.
+			sig := make([]byte, 65)
+			copy(sig[12:32], approver.Bytes())
+			sig[64] = 1
+			signatures = append(signatures, &SafeSignature{Signer: approver, Signature: sig})
+		}
+	}
+	sortSafeSignatures(signatures)
+
+	encoded := make([]byte, 0, 65*len(signatures))
+	for _, signature := range signatures {
+		encoded = append(encoded, signature.Signature...)
+	}
+	return encoded
+}
+
+func sortSafeSignatures(signatures []*SafeSignature) {
+	sort.Slice(signatures, func(i, j int) bool {
+		return bytes.Compare(signatures[i].Signer.Bytes(), signatures[j].Signer.Bytes()) < 0
+	})
+}
+
+// safeTransactionJSON is the JSON form of a Safe transaction, shared between
+// its owners as they sign.
+type safeTransactionJSON struct {
+	Safe           string               `json:"safe"`
+	ChainID        string               `json:"chainId"`
+	Version        string               `json:"version"`
+	To             string               `json:"to"`
+	Value          string               `json:"value"`
+	Data           string               `json:"data"`
+	Operation      uint8                `json:"operation"`
+	SafeTxGas      string               `json:"safeTxGas"`
+	BaseGas        string               `json:"baseGas"`
+	GasPrice       string               `json:"gasPrice"`
+	GasToken       string               `json:"gasToken"`
+	RefundReceiver string               `json:"refundReceiver"`
+	Nonce          string               `json:"nonce"`
+	SafeTxHash     string               `json:"safeTxHash"`
+	Signatures     []*safeSignatureJSON `json:"signatures"`
+}
+
+type safeSignatureJSON struct {
+	Signer    string `json:"signer"`
+	Signature string `json:"signature"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t *SafeTransaction) MarshalJSON() ([]byte, error) {
+	hash, err := t.Hash()
+	if err != nil {
+		return nil, err
+	}
+	signatures := make([]*safeSignatureJSON, len(t.Signatures))
+	for i, signature := range t.Signatures {
+		signatures[i] = &safeSignatureJSON{
+			Signer:    signature.Signer.Hex(),
+			Signature: hexutil.Encode(signature.Signature),
+		}
+	}
+	return json.Marshal(&safeTransactionJSON{
+		Safe:           t.Safe.Hex(),
+		ChainID:        t.ChainID.String(),
+		Version:        t.Version,
+		To:             t.To.Hex(),
+		Value:          t.Value.String(),
+		Data:           hexutil.Encode(t.Data),
+		Operation:      t.Operation,
+		SafeTxGas:      t.SafeTxGas.String(),
+		BaseGas:        t.BaseGas.String(),
+		GasPrice:       t.GasPrice.String(),
+		GasToken:       t.GasToken.Hex(),
+		RefundReceiver: t.RefundReceiver.Hex(),
+		Nonce:          t.Nonce.String(),
+		SafeTxHash:     hash.Hex(),
+		Signatures:     signatures,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.  The hash, if present, and the
+// signatures are checked against the transaction.
+func (t *SafeTransaction) UnmarshalJSON(input []byte) error {
+	var data safeTransactionJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return err
+	}
+
+	var err error
+	addresses := []struct {
+		name  string
+		input string
+		dest  *common.Address
+	}{
+		{"safe", data.Safe, &t.Safe},
+		{"to", data.To, &t.To},
+		{"gasToken", data.GasToken, &t.GasToken},
+		{"refundReceiver", data.RefundReceiver, &t.RefundReceiver},
+	}
+	for _, address := range addresses {
+		if !common.IsHexAddress(address.input) {
+			return fmt.Errorf("invalid %s %q", address.name, address.input)
+		}
+		*address.dest = common.HexToAddress(address.input)
+	}
+	values := []struct {
+		name  string
+		input string
+		dest  **big.Int
+	}{
+		{"chainId", data.ChainID, &t.ChainID},
+		{"value", data.Value, &t.Value},
+		{"safeTxGas", data.SafeTxGas, &t.SafeTxGas},
+		{"baseGas", data.BaseGas, &t.BaseGas},
+		{"gasPrice", data.GasPrice, &t.GasPrice},
+		{"nonce", data.Nonce, &t.Nonce},
+	}
+	for _, value := range values {
+		val, success := new(big.Int).SetString(value.input, 10)
+		if !success || val.Sign() < 0 {
+			return fmt.Errorf("invalid %s %q", value.name, value.input)
+		}
+		*value.dest = val
+	}
+	t.Version = data.Version
+	t.Data, err = hexutil.Decode(data.Data)
+	if err != nil {
+		return fmt.Errorf("invalid data: %v", err)
+	}
+	if data.Operation != SafeOperationCall && data.Operation != SafeOperationDelegateCall {
+		return fmt.Errorf("invalid operation %d", data.Operation)
+	}
+	t.Operation = data.Operation
+
+	hash, err := t.Hash()
+	if err != nil {
+		return err
+	}
+	if data.SafeTxHash != "" && !strings.EqualFold(data.SafeTxHash, hash.Hex()) {
+		return fmt.Errorf("safeTxHash %s does not match transaction hash %s", data.SafeTxHash, hash.Hex())
+	}
+
+	t.Signatures = make([]*SafeSignature, 0, len(data.Signatures))
+	for _, signature := range data.Signatures {
+		if !common.IsHexAddress(signature.Signer) {
+			return fmt.Errorf("invalid signer %q", signature.Signer)
+		}
+		sig, err := hexutil.Decode(signature.Signature)
+		if err != nil {
+			return fmt.Errorf("invalid signature from %s: %v", signature.Signer, err)
+		}
+		if err := t.AddSignature(common.HexToAddress(signature.Signer), sig); err != nil {
+			return fmt.Errorf("invalid signature from %s: %v", signature.Signer, err)
+		}
+	}
+	return nil
+}
+
+// EncodeMultiSend encodes a batch of calls as the transactions argument of
+// MultiSend's multiSend function.
+func EncodeMultiSend(calls []*SafeCall) []byte {
+	encoded := make([]byte, 0)
+	for _, call := range calls {
+		value := call.Value
+		if value == nil {
+			value = big.NewInt(0)
+		}
+		length := make([]byte, 32)
+		binary.BigEndian.PutUint64(length[24:], uint64(len(call.Data)))
+		encoded = append(encoded, SafeOperationCall)
+		encoded = append(encoded, call.To.Bytes()...)
+		encoded = append(encoded, common.LeftPadBytes(value.Bytes(), 32)...)
+		encoded = append(encoded, length...)
+		encoded = append(encoded, call.Data...)
+	}
+	return encoded
+}
+
+// MultiSendData returns the data of a call to multiSend for a batch of calls.
+func MultiSendData(calls []*SafeCall) ([]byte, error) {
+	multiSendABI, err := contracts.MultiSendMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return multiSendABI.Pack("multiSend", EncodeMultiSend(calls))
+}
+
+// DecodeMultiSendData decodes the data of a call to multiSend in to its
+// batch of calls.
+func DecodeMultiSendData(data []byte) ([]*SafeCall, error) {
+	multiSendABI, err := contracts.MultiSendMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	method := multiSendABI.Methods["multiSend"]
+	if len(data) < 4 || !bytes.Equal(data[:4], method.ID) {
+		return nil, errors.New("not a call to multiSend")
+	}
+	args, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil, err
+	}
+	encoded := args[0].([]byte)
+
+	calls := make([]*SafeCall, 0)
+	for offset := 0; offset < len(encoded); {
+		if len(encoded)-offset < 85 {
+			return nil, errors.New("truncated multiSend transactions")
+		}
+		if encoded[offset] != SafeOperationCall {
+			return nil, fmt.Errorf("unsupported operation %d in multiSend transactions", encoded[offset])
+		}
+		call := &SafeCall{
+			To:    common.BytesToAddress(encoded[offset+1 : offset+21]),
+			Value: new(big.Int).SetBytes(encoded[offset+21 : offset+53]),
+		}
+		length := new(big.Int).SetBytes(encoded[offset+53 : offset+85])
+		offset += 85
+		if !length.IsUint64() || length.Uint64() > uint64(len(encoded)-offset) {
+			return nil, errors.New("truncated multiSend transactions")
+		}
+		call.Data = encoded[offset : offset+int(length.Uint64())]
+		offset += int(length.Uint64())
+		calls = append(calls, call)
+	}
+	return calls, nil
+}
@@ -0,0 +1,244 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// safeTxHash calculates the hash of a Safe transaction as the Safe contract does.
+func safeTxHash(tx *SafeTransaction, withChainID bool) common.Hash {
+	word := func(val *big.Int) []byte { return common.LeftPadBytes(val.Bytes(), 32) }
+	address := func(val common.Address) []byte { return common.LeftPadBytes(val.Bytes(), 32) }
+
+	var domainSeparator []byte
+	if withChainID {
+		domainSeparator = crypto.Keccak256(
+			common.FromHex("0x47e79534a245952e8b16893a336b85a3d9ea9fa8c573f3d803afb92a79469218"),
+			word(tx.ChainID),
+			address(tx.Safe),
+		)
+	} else {
+		domainSeparator = crypto.Keccak256(
+			common.FromHex("0x035aff83d86937d35b32e04f0ddc6ff469290eef2f1b692d8a815c89404d4749"),
+			address(tx.Safe),
+		)
+	}
+	messageHash := crypto.Keccak256(
+		common.FromHex("0xbb8310d486368db6bd6f849402fdd73ad53d316b5a4b2644ad6efe0f941286d8"),
+		address(tx.To),
+		word(tx.Value),
+		crypto.Keccak256(tx.Data),
+		word(big.NewInt(int64(tx.Operation))),
+		word(tx.SafeTxGas),
+		word(tx.BaseGas),
+		word(tx.GasPrice),
+		address(tx.GasToken),
+		address(tx.RefundReceiver),
+		word(tx.Nonce),
+	)
+	return common.BytesToHash(crypto.Keccak256([]byte{0x19, 0x01}, domainSeparator, messageHash))
+}
+
+func TestSafeTransactionTypeHashes(t *testing.T) {
+	tx := NewSafeTransaction(common.Address{}, big.NewInt(1), "1.3.0", big.NewInt(0), common.Address{}, big.NewInt(0), nil, SafeOperationCall)
+	typedData, err := tx.TypedData()
+	require.Nil(t, err)
+
+	assert.Equal(t, "0xbb8310d486368db6bd6f849402fdd73ad53d316b5a4b2644ad6efe0f941286d8", typedData.TypeHash("SafeTx").String())
+	assert.Equal(t, "0x47e79534a245952e8b16893a336b85a3d9ea9fa8c573f3d803afb92a79469218", typedData.TypeHash("EIP712Domain").String())
+
+	tx.Version = "1.2.0"
+	typedData, err = tx.TypedData()
+	require.Nil(t, err)
+	assert.Equal(t, "0x035aff83d86937d35b32e04f0ddc6ff469290eef2f1b692d8a815c89404d4749", typedData.TypeHash("EIP712Domain").String())
+}
+
+func TestSafeTransactionHash(t *testing.T) {
+	safe := common.HexToAddress("0x1c8b9b78e3085866521fe206fa4c1a67f49f153a")
+	to := common.HexToAddress("0x5FfC014343cd971B7eb70732021E26C35B744cc4")
+
+	tests := []struct {
+		version     string
+		withChainID bool
+		data        []byte
+		operation   uint8
+		err         string
+	}{
+		{ // 0
+			version:     "1.3.0",
+			withChainID: true,
+		},
+		{ // 1
+			version:     "1.3.0+L2",
+			withChainID: true,
+			data:        common.FromHex("0xa9059cbb0000000000000000000000005ffc014343cd971b7eb70732021e26c35b744cc40000000000000000000000000000000000000000000000000de0b6b3a7640000"),
+		},
+		{ // 2
+			version:     "1.4.1",
+			withChainID: true,
+			operation:   SafeOperationDelegateCall,
+		},
+		{ // 3
+			version: "1.2.0",
+			data:    []byte{0x01},
+		},
+		{ // 4
+			version: "1.1.1",
+		},
+		{ // 5
+			version: "0.1.0",
+			err:     "unsupported Safe version 0.1.0",
+		},
+		{ // 6
+			version: "bad",
+			err:     `invalid Safe version "bad"`,
+		},
+	}
+
+	for i, test := range tests {
+		tx := NewSafeTransaction(safe, big.NewInt(5), test.version, big.NewInt(12), to, big.NewInt(1000000000000000000), test.data, test.operation)
+		hash, err := tx.Hash()
+		if test.err != "" {
+			assert.EqualError(t, err, test.err, fmt.Sprintf("incorrect error at test %d", i))
+			continue
+		}
+		require.Nil(t, err, fmt.Sprintf("unexpected error at test %d", i))
+		assert.Equal(t, safeTxHash(tx, test.withChainID), hash, fmt.Sprintf("incorrect hash at test %d", i))
+	}
+}
+
+func TestSafeTransactionSignatures(t *testing.T) {
+	keys := []string{
+		"ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80",
+		"59c6995e998f97a5a0044966f0945389dc9e86dae88c7a8412f4603b6b78690d",
+		"5de4111afa1a4b94908f83103eb1f1706367c2e68ca870fc3fb9a804cdab365a",
+	}
+
+	safe := common.HexToAddress("0x1c8b9b78e3085866521fe206fa4c1a67f49f153a")
+	tx := NewSafeTransaction(safe, big.NewInt(1), "1.3.0", big.NewInt(0), common.HexToAddress("0x5FfC014343cd971B7eb70732021E26C35B744cc4"), big.NewInt(1), nil, SafeOperationCall)
+	other := NewSafeTransaction(safe, big.NewInt(1), "1.3.0", big.NewInt(0), common.HexToAddress("0x5FfC014343cd971B7eb70732021E26C35B744cc4"), big.NewInt(1), nil, SafeOperationCall)
+	hash, err := tx.Hash()
+	require.Nil(t, err)
+
+	signers := make([]common.Address, 0)
+	for i, keyHex := range keys {
+		key, err := crypto.HexToECDSA(keyHex)
+		require.Nil(t, err)
+		signer := crypto.PubkeyToAddress(key.PublicKey)
+		signers = append(signers, signer)
+		signature, err := crypto.Sign(hash.Bytes(), key)
+		require.Nil(t, err)
+		if i == 0 {
+			require.Nil(t, tx.AddSignature(signer, signature))
+			// Adding the signature again replaces it.
+			require.Nil(t, tx.AddSignature(signer, signature))
+		} else {
+			require.Nil(t, other.AddSignature(signer, signature))
+		}
+	}
+	assert.EqualError(t, tx.AddSignature(signers[1], tx.Signatures[0].Signature), fmt.Sprintf("signature is not from %s", signers[1].Hex()))
+	assert.EqualError(t, tx.AddSignature(signers[1], []byte{0x01}), "invalid signature length 1")
+	require.Len(t, tx.Signatures, 1)
+	assert.Contains(t, []byte{27, 28}, tx.Signatures[0].Signature[64])
+
+	require.Nil(t, tx.Merge(other))
+	require.Len(t, tx.Signatures, 3)
+	for i := 1; i < len(tx.Signatures); i++ {
+		assert.Equal(t, -1, tx.Signatures[i-1].Signer.Hash().Big().Cmp(tx.Signatures[i].Signer.Hash().Big()), fmt.Sprintf("signatures out of order at %d", i))
+	}
+	assert.Len(t, tx.Signers(signers[1:]), 2)
+
+	// Approval by an owner that has not signed is added in order.
+	approver := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	encoded := tx.EncodedSignatures(approver, signers[0])
+	require.Len(t, encoded, 65*4)
+	assert.Equal(t, append(append(common.LeftPadBytes(approver.Bytes(), 32), make([]byte, 32)...), 0x01), encoded[:65])
+	assert.Equal(t, tx.Signatures[0].Signature, encoded[65:130])
+
+	// Merging a different transaction fails.
+	other.Nonce = big.NewInt(1)
+	assert.NotNil(t, tx.Merge(other))
+
+	// Round trip through JSON.
+	data, err := json.Marshal(tx)
+	require.Nil(t, err)
+	var decoded SafeTransaction
+	require.Nil(t, json.Unmarshal(data, &decoded))
+	decodedHash, err := decoded.Hash()
+	require.Nil(t, err)
+	assert.Equal(t, hash, decodedHash)
+	assert.Equal(t, tx.Signatures, decoded.Signatures)
+
+	// Altering the transaction invalidates the hash.
+	var raw map[string]interface{}
+	require.Nil(t, json.Unmarshal(data, &raw))
+	raw["nonce"] = "1"
+	altered, err := json.Marshal(raw)
+	require.Nil(t, err)
+	assert.NotNil(t, json.Unmarshal(altered, &decoded))
+}
+
+func TestMultiSendData(t *testing.T) {
+	calls := []*SafeCall{
+		{
+			To:    common.HexToAddress("0x5FfC014343cd971B7eb70732021E26C35B744cc4"),
+			Value: big.NewInt(1000),
+		},
+		{
+			To:   common.HexToAddress("0x1c8b9b78e3085866521fe206fa4c1a67f49f153a"),
+			Data: []byte{0x01, 0x02, 0x03},
+		},
+	}
+
+	encoded := EncodeMultiSend(calls)
+	assert.Equal(t, common.FromHex("0x"+
+		"00"+"5ffc014343cd971b7eb70732021e26c35b744cc4"+
+		"00000000000000000000000000000000000000000000000000000000000003e8"+
+		"0000000000000000000000000000000000000000000000000000000000000000"+
+		"00"+"1c8b9b78e3085866521fe206fa4c1a67f49f153a"+
+		"0000000000000000000000000000000000000000000000000000000000000000"+
+		"0000000000000000000000000000000000000000000000000000000000000003"+
+		"010203"), encoded)
+
+	data, err := MultiSendData(calls)
+	require.Nil(t, err)
+	assert.Equal(t, common.FromHex("0x8d80ff0a"), data[:4])
+
+	decoded, err := DecodeMultiSendData(data)
+	require.Nil(t, err)
+	require.Len(t, decoded, 2)
+	assert.Equal(t, calls[0].To, decoded[0].To)
+	assert.Equal(t, calls[0].Value, decoded[0].Value)
+	assert.Len(t, decoded[0].Data, 0)
+	assert.Equal(t, calls[1].To, decoded[1].To)
+	assert.Equal(t, int64(0), decoded[1].Value.Int64())
+	assert.Equal(t, calls[1].Data, decoded[1].Data)
+
+	_, err = DecodeMultiSendData([]byte{0x01, 0x02, 0x03, 0x04})
+	assert.EqualError(t, err, "not a call to multiSend")
+	truncated, err := MultiSendData(nil)
+	require.Nil(t, err)
+	decoded, err = DecodeMultiSendData(truncated)
+	require.Nil(t, err)
+	assert.Len(t, decoded, 0)
+}
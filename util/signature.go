@@ -0,0 +1,234 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/wealdtech/ethereal/util/contracts"
+)
+
+// Schemes by which a signature can be valid.
+const (
+	// SignatureSchemeECDSA is a signature by the key of an externally owned account.
+	SignatureSchemeECDSA = "ECDSA"
+	// SignatureSchemeERC1271 is a signature accepted by isValidSignature(bytes32,bytes) of a contract account.
+	SignatureSchemeERC1271 = "ERC-1271"
+	// SignatureSchemeERC1271Legacy is a signature accepted by isValidSignature(bytes,bytes) of a contract account.
+	SignatureSchemeERC1271Legacy = "ERC-1271 (legacy)"
+	// SignatureSchemeEIP6492 is a signature accepted by a contract account that is yet to be deployed.
+	SignatureSchemeEIP6492 = "EIP-6492"
+)
+
+var (
+	// erc1271MagicValue is returned by isValidSignature(bytes32,bytes) for a valid signature.
+	erc1271MagicValue = [4]byte{0x16, 0x26, 0xba, 0x7e}
+	// erc1271LegacyMagicValue is returned by isValidSignature(bytes,bytes) for a valid signature.
+	erc1271LegacyMagicValue = [4]byte{0x20, 0xc1, 0x3b, 0x0b}
+	// eip6492Suffix is the suffix of a signature wrapped for a contract account that is yet to be deployed.
+	eip6492Suffix = common.FromHex("0x6492649264926492649264926492649264926492649264926492649264926492")
+)
+
+// IsEIP6492Signature returns true if the signature is wrapped as per EIP-6492.
+func IsEIP6492Signature(signature []byte) bool {
+	return len(signature) > len(eip6492Suffix) && bytes.HasSuffix(signature, eip6492Suffix)
+}
+
+// UnwrapEIP6492Signature returns the factory, factory calldata and signature
+// of a signature wrapped as per EIP-6492.
+func UnwrapEIP6492Signature(signature []byte) (common.Address, []byte, []byte, error) {
+	if !IsEIP6492Signature(signature) {
+		return common.Address{}, nil, nil, errors.New("not an EIP-6492 signature")
+	}
+	addressType, err := abi.NewType("address", "", nil)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	bytesType, err := abi.NewType("bytes", "", nil)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	args := abi.Arguments{{Type: addressType}, {Type: bytesType}, {Type: bytesType}}
+	values, err := args.Unpack(signature[:len(signature)-len(eip6492Suffix)])
+	if err != nil {
+		return common.Address{}, nil, nil, fmt.Errorf("invalid EIP-6492 signature: %v", err)
+	}
+	return values[0].(common.Address), values[1].([]byte), values[2].([]byte), nil
+}
+
+// VerifySignature verifies the signature of a hash by a signer, returning
+// the scheme by which the signature is valid or an empty string if it is not
+// valid.  The signature is checked as an ECDSA signature and, if caller is
+// supplied, with ERC-1271 if the signer is a contract account or EIP-6492 if
+// the signer is a contract account that is yet to be deployed.
+func VerifySignature(ctx context.Context, caller bind.ContractCaller, signer common.Address, hash []byte, signature []byte) (string, error) {
+	if IsEIP6492Signature(signature) {
+		if caller == nil {
+			return "", nil
+		}
+		factory, factoryCalldata, innerSignature, err := UnwrapEIP6492Signature(signature)
+		if err != nil {
+			return "", err
+		}
+		code, err := caller.CodeAt(ctx, signer, nil)
+		if err != nil {
+			return "", err
+		}
+		if len(code) > 0 {
+			// Already deployed, so the wrapper is not required.
+			return verifyERC1271Signature(ctx, caller, signer, hash, innerSignature)
+		}
+		return verifyEIP6492Signature(ctx, caller, signer, hash, factory, factoryCalldata, innerSignature)
+	}
+
+	if len(signature) == 65 {
+		sig := make([]byte, 65)
+		copy(sig, signature)
+		if sig[64] == 27 || sig[64] == 28 {
+			sig[64] -= 27
+		}
+		if key, err := crypto.SigToPub(hash, sig); err == nil && crypto.PubkeyToAddress(*key) == signer {
+			return SignatureSchemeECDSA, nil
+		}
+	}
+	if caller == nil {
+		return "", nil
+	}
+
+	code, err := caller.CodeAt(ctx, signer, nil)
+	if err != nil {
+		return "", err
+	}
+	if len(code) == 0 {
+		return "", nil
+	}
+	return verifyERC1271Signature(ctx, caller, signer, hash, signature)
+}
+
+// verifyERC1271Signature verifies the signature of a hash with the
+// isValidSignature function of a contract account, trying the legacy
+// variant that takes the data as bytes if the current variant fails.
+func verifyERC1271Signature(ctx context.Context, caller bind.ContractCaller, signer common.Address, hash []byte, signature []byte) (string, error) {
+	contract, err := contracts.NewERC1271Caller(signer, caller)
+	if err != nil {
+		return "", err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+
+	// Calls that fail are treated as the signature not being valid, as
+	// contracts revert rather than return if they do not implement the
+	// function or do not accept the signature.
+	var hash32 [32]byte
+	copy(hash32[:], hash)
+	if res, err := contract.IsValidSignature(opts, hash32, signature); err == nil && res == erc1271MagicValue {
+		return SignatureSchemeERC1271, nil
+	}
+	if res, err := contract.IsValidSignature0(opts, hash, signature); err == nil && res == erc1271LegacyMagicValue {
+		return SignatureSchemeERC1271Legacy, nil
+	}
+	return "", nil
+}
+
+// verifyEIP6492Signature verifies the signature of a hash by a contract
+// account that is yet to be deployed.  This is carried out in a single call
+// that deploys the account through its factory then calls its
+// isValidSignature function.
+func verifyEIP6492Signature(ctx context.Context,
+	caller bind.ContractCaller,
+	signer common.Address,
+	hash []byte,
+	factory common.Address,
+	factoryCalldata []byte,
+	signature []byte,
+) (string, error) {
+	contractABI, err := contracts.ERC1271MetaData.GetAbi()
+	if err != nil {
+		return "", err
+	}
+	var hash32 [32]byte
+	copy(hash32[:], hash)
+	validateCalldata, err := contractABI.Pack("isValidSignature", hash32, signature)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := caller.CallContract(ctx, ethereum.CallMsg{
+		Data: eip6492Validator(factory, factoryCalldata, signer, validateCalldata),
+	}, nil)
+	if err != nil || len(res) < 4 {
+		// The account failed to deploy or did not accept the signature.
+		return "", nil
+	}
+	if bytes.Equal(res[:4], erc1271MagicValue[:]) {
+		return SignatureSchemeEIP6492, nil
+	}
+	return "", nil
+}
+
+// eip6492Validator returns contract creation code that calls the factory with
+// the factory calldata to deploy the signer, then calls the signer with the
+// validation calldata and returns the result.  Creation code run with
+// eth_call returns what would be the code of the contract, so this returns
+// the result of the validation without deploying anything.
+func eip6492Validator(factory common.Address, factoryCalldata []byte, signer common.Address, validateCalldata []byte) []byte {
+	push4 := func(code []byte, val int) []byte {
+		buf := make([]byte, 4)
+		binary.BigEndian.PutUint32(buf, uint32(val))
+		return append(append(code, byte(vm.PUSH4)), buf...)
+	}
+	program := func(factoryCalldataOffset int, validateCalldataOffset int) []byte {
+		code := make([]byte, 0)
+		// Deploy the signer: call(gas, factory, 0, 0, len(factoryCalldata), 0, 0).
+		code = push4(code, len(factoryCalldata))
+		code = push4(code, factoryCalldataOffset)
+		code = append(code, byte(vm.PUSH1), 0x00, byte(vm.CODECOPY))
+		code = append(code, byte(vm.PUSH1), 0x00, byte(vm.PUSH1), 0x00)
+		code = push4(code, len(factoryCalldata))
+		code = append(code, byte(vm.PUSH1), 0x00, byte(vm.PUSH1), 0x00, byte(vm.PUSH20))
+		code = append(code, factory.Bytes()...)
+		code = append(code, byte(vm.GAS), byte(vm.CALL), byte(vm.POP))
+		// Validate: staticcall(gas, signer, 0, len(validateCalldata), 0, 0).
+		code = push4(code, len(validateCalldata))
+		code = push4(code, validateCalldataOffset)
+		code = append(code, byte(vm.PUSH1), 0x00, byte(vm.CODECOPY))
+		code = append(code, byte(vm.PUSH1), 0x00, byte(vm.PUSH1), 0x00)
+		code = push4(code, len(validateCalldata))
+		code = append(code, byte(vm.PUSH1), 0x00, byte(vm.PUSH20))
+		code = append(code, signer.Bytes()...)
+		code = append(code, byte(vm.GAS), byte(vm.STATICCALL))
+		// Revert if the validation call failed, otherwise return its result.
+		code = append(code, byte(vm.PUSH1), byte(len(code)+7), byte(vm.JUMPI))
+		code = append(code, byte(vm.PUSH1), 0x00, byte(vm.DUP1), byte(vm.REVERT))
+		code = append(code, byte(vm.JUMPDEST))
+		code = append(code, byte(vm.RETURNDATASIZE), byte(vm.PUSH1), 0x00, byte(vm.PUSH1), 0x00, byte(vm.RETURNDATACOPY))
+		code = append(code, byte(vm.RETURNDATASIZE), byte(vm.PUSH1), 0x00, byte(vm.RETURN))
+		return code
+	}
+
+	// The length of the program does not depend on the offsets.
+	programLen := len(program(0, 0))
+	code := program(programLen, programLen+len(factoryCalldata))
+	code = append(code, factoryCalldata...)
+	return append(code, validateCalldata...)
+}
@@ -0,0 +1,265 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ecdsaWalletCode returns the code of a contract account that implements
+// isValidSignature(bytes32,bytes), accepting 65-byte signatures by owner.
+func ecdsaWalletCode(owner common.Address) []byte {
+	return acceptIf(recoversTo(4, 100, owner), erc1271MagicValue)
+}
+
+// legacyWalletCode returns the code of a contract account that implements
+// isValidSignature(bytes,bytes), accepting 65-byte signatures by owner of
+// 32-byte data.
+func legacyWalletCode(owner common.Address) []byte {
+	code := []byte{
+		byte(vm.PUSH1), 0, byte(vm.CALLDATALOAD), byte(vm.PUSH1), 224, byte(vm.SHR),
+		byte(vm.PUSH4), 0x20, 0xc1, 0x3b, 0x0b, byte(vm.EQ),
+	}
+	code = append(code, recoversTo(100, 164, owner)...)
+	code = append(code, byte(vm.AND))
+	return acceptIf(code, erc1271LegacyMagicValue)
+}
+
+// recoversTo returns code that leaves true on the stack if the 65-byte
+// signature at sigOffset in the calldata is by owner of the hash at
+// hashOffset.
+func recoversTo(hashOffset byte, sigOffset byte, owner common.Address) []byte {
+	code := []byte{
+		// Place hash, v, r and s in memory for ecrecover.
+		byte(vm.PUSH1), hashOffset, byte(vm.CALLDATALOAD), byte(vm.PUSH1), 0, byte(vm.MSTORE),
+		byte(vm.PUSH1), sigOffset + 64, byte(vm.CALLDATALOAD), byte(vm.PUSH1), 248, byte(vm.SHR), byte(vm.PUSH1), 32, byte(vm.MSTORE),
+		byte(vm.PUSH1), sigOffset, byte(vm.CALLDATALOAD), byte(vm.PUSH1), 64, byte(vm.MSTORE),
+		byte(vm.PUSH1), sigOffset + 32, byte(vm.CALLDATALOAD), byte(vm.PUSH1), 96, byte(vm.MSTORE),
+		// staticcall(gas, 1, 0, 128, 0, 32).
+		byte(vm.PUSH1), 32, byte(vm.PUSH1), 0, byte(vm.PUSH1), 128, byte(vm.PUSH1), 0, byte(vm.PUSH1), 1,
+		byte(vm.GAS), byte(vm.STATICCALL), byte(vm.POP),
+		byte(vm.PUSH1), 0, byte(vm.MLOAD), byte(vm.PUSH20),
+	}
+	code = append(code, owner.Bytes()...)
+	return append(code, byte(vm.EQ))
+}
+
+// acceptIf appends code that returns the magic value if the top of the stack
+// is true, otherwise reverts.
+func acceptIf(code []byte, magicValue [4]byte) []byte {
+	code = append(code, byte(vm.PUSH1), byte(len(code)+7), byte(vm.JUMPI))
+	code = append(code, byte(vm.PUSH1), 0, byte(vm.DUP1), byte(vm.REVERT))
+	code = append(code, byte(vm.JUMPDEST), byte(vm.PUSH4))
+	code = append(code, magicValue[:]...)
+	return append(code,
+		byte(vm.PUSH1), 224, byte(vm.SHL), byte(vm.PUSH1), 0, byte(vm.MSTORE),
+		byte(vm.PUSH1), 32, byte(vm.PUSH1), 0, byte(vm.RETURN),
+	)
+}
+
+// factoryCode returns the code of a factory that deploys its calldata as
+// creation code with CREATE2 and a zero salt.
+func factoryCode() []byte {
+	return []byte{
+		byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.CALLDATACOPY),
+		byte(vm.PUSH1), 0, byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.CREATE2),
+		byte(vm.POP), byte(vm.STOP),
+	}
+}
+
+// creationCode returns the creation code for a contract with the given code.
+func creationCode(code []byte) []byte {
+	return append([]byte{
+		byte(vm.PUSH2), byte(len(code) >> 8), byte(len(code)), byte(vm.DUP1), byte(vm.PUSH1), 12, byte(vm.PUSH1), 0, byte(vm.CODECOPY),
+		byte(vm.PUSH1), 0, byte(vm.RETURN),
+	}, code...)
+}
+
+// wrapEIP6492Signature wraps a signature as per EIP-6492.
+func wrapEIP6492Signature(t *testing.T, factory common.Address, factoryCalldata []byte, signature []byte) []byte {
+	addressType, err := abi.NewType("address", "", nil)
+	require.Nil(t, err)
+	bytesType, err := abi.NewType("bytes", "", nil)
+	require.Nil(t, err)
+	wrapped, err := abi.Arguments{{Type: addressType}, {Type: bytesType}, {Type: bytesType}}.Pack(factory, factoryCalldata, signature)
+	require.Nil(t, err)
+	return append(wrapped, eip6492Suffix...)
+}
+
+func TestVerifySignature(t *testing.T) {
+	key, err := crypto.HexToECDSA("ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80")
+	require.Nil(t, err)
+	owner := crypto.PubkeyToAddress(key.PublicKey)
+	otherKey, err := crypto.HexToECDSA("59c6995e998f97a5a0044966f0945389dc9e86dae88c7a8412f4603b6b78690d")
+	require.Nil(t, err)
+
+	hash := crypto.Keccak256([]byte("Hello world"))
+	signature, err := crypto.Sign(hash, key)
+	require.Nil(t, err)
+	signature27 := append(append([]byte{}, signature[:64]...), signature[64]+27)
+	otherSignature27, err := crypto.Sign(hash, otherKey)
+	require.Nil(t, err)
+	otherSignature27[64] += 27
+
+	wallet := common.HexToAddress("0x0000000000000000000000000000000000001271")
+	legacyWallet := common.HexToAddress("0x0000000000000000000000000000000000000b0b")
+	factory := common.HexToAddress("0x000000000000000000000000000000000000fac7")
+	walletCreationCode := creationCode(ecdsaWalletCode(owner))
+	counterfactual := crypto.CreateAddress2(factory, [32]byte{}, crypto.Keccak256(walletCreationCode))
+
+	backend := backends.NewSimulatedBackend(core.GenesisAlloc{
+		wallet:       {Code: ecdsaWalletCode(owner), Balance: big.NewInt(0)},
+		legacyWallet: {Code: legacyWalletCode(owner), Balance: big.NewInt(0)},
+		factory:      {Code: factoryCode(), Balance: big.NewInt(0)},
+	}, 30000000)
+	defer backend.Close()
+
+	tests := []struct {
+		caller    bind.ContractCaller
+		signer    common.Address
+		signature []byte
+		scheme    string
+	}{
+		{ // 0
+			caller:    backend,
+			signer:    owner,
+			signature: signature,
+			scheme:    SignatureSchemeECDSA,
+		},
+		{ // 1
+			caller:    backend,
+			signer:    owner,
+			signature: signature27,
+			scheme:    SignatureSchemeECDSA,
+		},
+		{ // 2 - different signer
+			caller:    backend,
+			signer:    crypto.PubkeyToAddress(otherKey.PublicKey),
+			signature: signature,
+		},
+		{ // 3 - truncated signature
+			caller:    backend,
+			signer:    owner,
+			signature: signature[:64],
+		},
+		{ // 4
+			caller:    backend,
+			signer:    wallet,
+			signature: signature27,
+			scheme:    SignatureSchemeERC1271,
+		},
+		{ // 5 - signature not by owner
+			caller:    backend,
+			signer:    wallet,
+			signature: otherSignature27,
+		},
+		{ // 6
+			caller:    backend,
+			signer:    legacyWallet,
+			signature: signature27,
+			scheme:    SignatureSchemeERC1271Legacy,
+		},
+		{ // 7 - signature not by owner
+			caller:    backend,
+			signer:    legacyWallet,
+			signature: otherSignature27,
+		},
+		{ // 8
+			caller:    backend,
+			signer:    counterfactual,
+			signature: wrapEIP6492Signature(t, factory, walletCreationCode, signature27),
+			scheme:    SignatureSchemeEIP6492,
+		},
+		{ // 9 - signature not by owner
+			caller:    backend,
+			signer:    counterfactual,
+			signature: wrapEIP6492Signature(t, factory, walletCreationCode, otherSignature27),
+		},
+		{ // 10 - not wrapped
+			caller:    backend,
+			signer:    counterfactual,
+			signature: signature27,
+		},
+		{ // 11 - factory does not deploy the signer
+			caller:    backend,
+			signer:    counterfactual,
+			signature: wrapEIP6492Signature(t, factory, creationCode(legacyWalletCode(owner)), signature27),
+		},
+		{ // 12 - wrapped but already deployed
+			caller:    backend,
+			signer:    wallet,
+			signature: wrapEIP6492Signature(t, factory, walletCreationCode, signature27),
+			scheme:    SignatureSchemeERC1271,
+		},
+		{ // 13 - offline
+			signer:    owner,
+			signature: signature27,
+			scheme:    SignatureSchemeECDSA,
+		},
+		{ // 14 - offline
+			signer:    wallet,
+			signature: signature27,
+		},
+		{ // 15 - offline
+			signer:    counterfactual,
+			signature: wrapEIP6492Signature(t, factory, walletCreationCode, signature27),
+		},
+	}
+
+	for i, test := range tests {
+		scheme, err := VerifySignature(context.Background(), test.caller, test.signer, hash, test.signature)
+		require.Nil(t, err, fmt.Sprintf("unexpected error at test %d", i))
+		assert.Equal(t, test.scheme, scheme, fmt.Sprintf("incorrect scheme at test %d", i))
+	}
+
+	// Verification must not deploy the counterfactual signer.
+	code, err := backend.CodeAt(context.Background(), counterfactual, nil)
+	require.Nil(t, err)
+	assert.Len(t, code, 0)
+}
+
+func TestUnwrapEIP6492Signature(t *testing.T) {
+	factory := common.HexToAddress("0x000000000000000000000000000000000000fac7")
+	factoryCalldata := []byte{0x01, 0x02, 0x03}
+	signature := []byte{0x04, 0x05}
+
+	wrapped := wrapEIP6492Signature(t, factory, factoryCalldata, signature)
+	assert.True(t, IsEIP6492Signature(wrapped))
+	assert.False(t, IsEIP6492Signature(signature))
+	assert.False(t, IsEIP6492Signature(eip6492Suffix))
+
+	unwrappedFactory, unwrappedFactoryCalldata, unwrappedSignature, err := UnwrapEIP6492Signature(wrapped)
+	require.Nil(t, err)
+	assert.Equal(t, factory, unwrappedFactory)
+	assert.Equal(t, factoryCalldata, unwrappedFactoryCalldata)
+	assert.Equal(t, signature, unwrappedSignature)
+
+	_, _, _, err = UnwrapEIP6492Signature(signature)
+	assert.EqualError(t, err, "not an EIP-6492 signature")
+	_, _, _, err = UnwrapEIP6492Signature(append([]byte{0x01}, eip6492Suffix...))
+	assert.NotNil(t, err)
+}
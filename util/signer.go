@@ -15,8 +15,10 @@
 package util
 
 import (
+	"bytes"
 	"crypto/ecdsa"
 	"errors"
+	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/accounts"
@@ -28,12 +30,12 @@ import (
 
 // KeySigner generates a signer using a private key
 func KeySigner(chainID *big.Int, key *ecdsa.PrivateKey) (signerfn bind.SignerFn) {
-	signerfn = func(signer types.Signer, address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+	signerfn = func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
 		keyAddr := crypto.PubkeyToAddress(key.PublicKey)
 		if address != keyAddr {
 			return nil, errors.New("not authorized to sign this account")
 		}
-		return types.SignTx(tx, types.NewEIP155Signer(chainID), key)
+		return types.SignTx(tx, types.LatestSignerForChainID(chainID), key)
 	}
 
 	return
@@ -41,7 +43,7 @@ func KeySigner(chainID *big.Int, key *ecdsa.PrivateKey) (signerfn bind.SignerFn)
 
 // AccountSigner generates a signer using an account
 func AccountSigner(chainID *big.Int, wallet *accounts.Wallet, account *accounts.Account, passphrase string) (signerfn bind.SignerFn) {
-	signerfn = func(signer types.Signer, address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+	signerfn = func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
 		if address != account.Address {
 			return nil, errors.New("not authorized to sign this account")
 		}
@@ -49,3 +51,86 @@ func AccountSigner(chainID *big.Int, wallet *accounts.Wallet, account *accounts.
 	}
 	return
 }
+
+// WalletSigner generates a signer using a wallet that does not require a
+// passphrase, such as an external signer.  As the wallet could alter the
+// transaction the signed transaction is checked against that requested.
+func WalletSigner(chainID *big.Int, wallet accounts.Wallet, account accounts.Account) (signerfn bind.SignerFn) {
+	signerfn = func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		if address != account.Address {
+			return nil, errors.New("not authorized to sign this account")
+		}
+		signedTx, err := wallet.SignTx(account, tx, chainID)
+		if err != nil {
+			return nil, err
+		}
+		if err := CheckSignedTransaction(chainID, address, tx, signedTx); err != nil {
+			return nil, err
+		}
+		return signedTx, nil
+	}
+	return
+}
+
+// CheckSignedTransaction checks that a transaction returned by a signer is the
+// transaction that was requested, signed by the given address.
+func CheckSignedTransaction(chainID *big.Int, from common.Address, tx *types.Transaction, signedTx *types.Transaction) error {
+	if signedTx == nil {
+		return errors.New("signer did not return a transaction")
+	}
+	// Typed transactions carry their own chain ID.
+	if tx.Type() != types.LegacyTxType && tx.ChainId().Sign() != 0 {
+		chainID = tx.ChainId()
+	}
+	field := ""
+	switch {
+	case signedTx.Type() != tx.Type():
+		field = "type"
+	case chainID == nil || signedTx.ChainId().Cmp(chainID) != 0:
+		field = "chain ID"
+	case signedTx.Nonce() != tx.Nonce():
+		field = "nonce"
+	case (signedTx.To() == nil) != (tx.To() == nil) || (tx.To() != nil && *signedTx.To() != *tx.To()):
+		field = "recipient"
+	case signedTx.Value().Cmp(tx.Value()) != 0:
+		field = "value"
+	case !bytes.Equal(signedTx.Data(), tx.Data()):
+		field = "data"
+	case signedTx.Gas() != tx.Gas():
+		field = "gas limit"
+	case signedTx.GasPrice().Cmp(tx.GasPrice()) != 0 || signedTx.GasFeeCap().Cmp(tx.GasFeeCap()) != 0 || signedTx.GasTipCap().Cmp(tx.GasTipCap()) != 0:
+		field = "fees"
+	case !accessListsEqual(signedTx.AccessList(), tx.AccessList()):
+		field = "access list"
+	}
+	if field != "" {
+		return fmt.Errorf("signed transaction does not match the requested %s", field)
+	}
+
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), signedTx)
+	if err != nil {
+		return fmt.Errorf("invalid signature on signed transaction: %v", err)
+	}
+	if sender != from {
+		return fmt.Errorf("signed transaction is from %s rather than %s", sender.Hex(), from.Hex())
+	}
+	return nil
+}
+
+// accessListsEqual returns true if the access lists are the same.
+func accessListsEqual(a types.AccessList, b types.AccessList) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Address != b[i].Address || len(a[i].StorageKeys) != len(b[i].StorageKeys) {
+			return false
+		}
+		for j := range a[i].StorageKeys {
+			if a[i].StorageKeys[j] != b[i].StorageKeys[j] {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,287 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/external"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubSigner provides the account API of an external signer such as Clef,
+// signing with a single key.  If tamper is set it is applied to transactions
+// before they are signed, and if signWith is set transactions are signed with
+// it in place of key.
+type stubSigner struct {
+	key      *ecdsa.PrivateKey
+	tamper   func(args *apitypes.SendTxArgs)
+	signWith *ecdsa.PrivateKey
+}
+
+type stubSignTransactionResult struct {
+	Raw hexutil.Bytes      `json:"raw"`
+	Tx  *types.Transaction `json:"tx"`
+}
+
+func (s *stubSigner) Version() string {
+	return "6.0.0"
+}
+
+func (s *stubSigner) List() []common.Address {
+	return []common.Address{crypto.PubkeyToAddress(s.key.PublicKey)}
+}
+
+func (s *stubSigner) SignTransaction(args apitypes.SendTxArgs) (*stubSignTransactionResult, error) {
+	if args.From.Address() != crypto.PubkeyToAddress(s.key.PublicKey) {
+		return nil, errors.New("unknown account")
+	}
+	if s.tamper != nil {
+		s.tamper(&args)
+	}
+	key := s.key
+	if s.signWith != nil {
+		key = s.signWith
+	}
+	tx, err := types.SignTx(args.ToTransaction(), types.LatestSignerForChainID((*big.Int)(args.ChainID)), key)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return &stubSignTransactionResult{Raw: raw, Tx: tx}, nil
+}
+
+func (s *stubSigner) SignData(contentType string, addr common.MixedcaseAddress, data hexutil.Bytes) (hexutil.Bytes, error) {
+	if contentType != accounts.MimetypeTextPlain {
+		return nil, fmt.Errorf("unsupported content type %s", contentType)
+	}
+	signature, err := crypto.Sign(accounts.TextHash(data), s.key)
+	if err != nil {
+		return nil, err
+	}
+	// Clef returns signatures with V of 27 or 28.
+	signature[64] += 27
+	return signature, nil
+}
+
+func (s *stubSigner) SignTypedData(addr common.MixedcaseAddress, data json.RawMessage) (hexutil.Bytes, error) {
+	typedData, err := ParseTypedData(data)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := TypedDataHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := crypto.Sign(hash.Bytes(), s.key)
+	if err != nil {
+		return nil, err
+	}
+	signature[64] += 27
+	return signature, nil
+}
+
+// startStubSigner starts a stub external signer, returning its URL.
+func startStubSigner(t *testing.T, signer *stubSigner) string {
+	server := rpc.NewServer()
+	require.Nil(t, server.RegisterName("account", signer))
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+	return httpServer.URL
+}
+
+// newStubSigner starts a stub external signer, returning a connection to it.
+func newStubSigner(t *testing.T, signer *stubSigner) *external.ExternalSigner {
+	extSigner, err := external.NewExternalSigner(startStubSigner(t, signer))
+	require.Nil(t, err)
+	return extSigner
+}
+
+func TestWalletSigner(t *testing.T) {
+	key, err := crypto.HexToECDSA("ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80")
+	require.Nil(t, err)
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	other := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	chainID := big.NewInt(5)
+	signer := newStubSigner(t, &stubSigner{key: key})
+
+	assert.Equal(t, []accounts.Account{{Address: address, URL: signer.URL()}}, signer.Accounts())
+
+	to := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	tests := []struct {
+		account accounts.Account
+		from    common.Address
+		tx      *types.Transaction
+		err     string
+	}{
+		{ // 0 - legacy
+			account: accounts.Account{Address: address},
+			from:    address,
+			tx: types.NewTx(&types.LegacyTx{
+				Nonce:    1,
+				GasPrice: big.NewInt(1000000000),
+				Gas:      21000,
+				To:       &to,
+				Value:    big.NewInt(1),
+			}),
+		},
+		{ // 1 - dynamic fee
+			account: accounts.Account{Address: address},
+			from:    address,
+			tx: types.NewTx(&types.DynamicFeeTx{
+				ChainID:   chainID,
+				Nonce:     2,
+				GasTipCap: big.NewInt(1000000000),
+				GasFeeCap: big.NewInt(2000000000),
+				Gas:       50000,
+				To:        &to,
+				Data:      []byte{0x01, 0x02},
+			}),
+		},
+		{ // 2 - different sender
+			account: accounts.Account{Address: address},
+			from:    other,
+			tx:      types.NewTx(&types.LegacyTx{Gas: 21000, To: &to}),
+			err:     "not authorized to sign this account",
+		},
+		{ // 3 - account unknown to signer
+			account: accounts.Account{Address: other},
+			from:    other,
+			tx:      types.NewTx(&types.LegacyTx{Gas: 21000, To: &to}),
+			err:     "unknown account",
+		},
+	}
+
+	for i, test := range tests {
+		signed, err := WalletSigner(chainID, signer, test.account)(test.from, test.tx)
+		if test.err != "" {
+			assert.EqualError(t, err, test.err, fmt.Sprintf("incorrect error at test %d", i))
+			continue
+		}
+		require.Nil(t, err, fmt.Sprintf("unexpected error at test %d", i))
+		sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+		require.Nil(t, err, fmt.Sprintf("failed to obtain sender at test %d", i))
+		assert.Equal(t, address, sender, fmt.Sprintf("incorrect sender at test %d", i))
+		assert.Equal(t, test.tx.Nonce(), signed.Nonce(), fmt.Sprintf("incorrect nonce at test %d", i))
+		assert.Equal(t, hexutil.Encode(test.tx.Data()), hexutil.Encode(signed.Data()), fmt.Sprintf("incorrect data at test %d", i))
+	}
+}
+
+func TestWalletSignerTampered(t *testing.T) {
+	key, err := crypto.HexToECDSA("ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80")
+	require.Nil(t, err)
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	otherKey, err := crypto.HexToECDSA("59c6995e998f97a5a0044966f0945389dc9e86dae88c7a8412f4603b6b78690d")
+	require.Nil(t, err)
+	chainID := big.NewInt(5)
+
+	to := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	other := common.NewMixedcaseAddress(common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8"))
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     2,
+		GasTipCap: big.NewInt(1000000000),
+		GasFeeCap: big.NewInt(2000000000),
+		Gas:       50000,
+		To:        &to,
+		Value:     big.NewInt(1),
+		Data:      []byte{0x01, 0x02},
+	})
+
+	tests := []struct {
+		tamper   func(args *apitypes.SendTxArgs)
+		signWith *ecdsa.PrivateKey
+		err      string
+	}{
+		{ // 0 - recipient
+			tamper: func(args *apitypes.SendTxArgs) { args.To = &other },
+			err:    "signed transaction does not match the requested recipient",
+		},
+		{ // 1 - value
+			tamper: func(args *apitypes.SendTxArgs) { args.Value = hexutil.Big(*big.NewInt(1000)) },
+			err:    "signed transaction does not match the requested value",
+		},
+		{ // 2 - data
+			tamper: func(args *apitypes.SendTxArgs) { data := hexutil.Bytes{0x03}; args.Data = &data },
+			err:    "signed transaction does not match the requested data",
+		},
+		{ // 3 - nonce
+			tamper: func(args *apitypes.SendTxArgs) { args.Nonce = 3 },
+			err:    "signed transaction does not match the requested nonce",
+		},
+		{ // 4 - gas
+			tamper: func(args *apitypes.SendTxArgs) { args.Gas = 100000 },
+			err:    "signed transaction does not match the requested gas limit",
+		},
+		{ // 5 - fees
+			tamper: func(args *apitypes.SendTxArgs) { args.MaxFeePerGas = (*hexutil.Big)(big.NewInt(3000000000)) },
+			err:    "signed transaction does not match the requested fees",
+		},
+		{ // 6 - chain ID
+			tamper: func(args *apitypes.SendTxArgs) { args.ChainID = (*hexutil.Big)(big.NewInt(1)) },
+			err:    "signed transaction does not match the requested chain ID",
+		},
+		{ // 7 - type
+			tamper: func(args *apitypes.SendTxArgs) {
+				args.GasPrice = args.MaxFeePerGas
+				args.MaxFeePerGas = nil
+				args.MaxPriorityFeePerGas = nil
+				args.AccessList = nil
+			},
+			err: "signed transaction does not match the requested type",
+		},
+		{ // 8 - signed by another key
+			signWith: otherKey,
+			err:      fmt.Sprintf("signed transaction is from %s rather than %s", crypto.PubkeyToAddress(otherKey.PublicKey).Hex(), address.Hex()),
+		},
+	}
+
+	for i, test := range tests {
+		signer := newStubSigner(t, &stubSigner{key: key, tamper: test.tamper, signWith: test.signWith})
+		_, err := WalletSigner(chainID, signer, accounts.Account{Address: address})(address, tx)
+		assert.EqualError(t, err, test.err, fmt.Sprintf("incorrect error at test %d", i))
+	}
+}
+
+func TestExternalSignText(t *testing.T) {
+	key, err := crypto.HexToECDSA("ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80")
+	require.Nil(t, err)
+	signer := newStubSigner(t, &stubSigner{key: key})
+
+	data := []byte("Hello world")
+	signature, err := signer.SignText(accounts.Account{Address: crypto.PubkeyToAddress(key.PublicKey)}, data)
+	require.Nil(t, err)
+
+	// Signature should match a local signature of the same text.
+	expected, err := crypto.Sign(accounts.TextHash(data), key)
+	require.Nil(t, err)
+	assert.Equal(t, expected, signature)
+}
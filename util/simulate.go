@@ -0,0 +1,190 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// PrestateAccount is the state of an account as generated by the node's
+// prestateTracer.
+type PrestateAccount struct {
+	Balance *hexutil.Big                `json:"balance,omitempty"`
+	Nonce   uint64                      `json:"nonce,omitempty"`
+	Code    hexutil.Bytes               `json:"code,omitempty"`
+	Storage map[common.Hash]common.Hash `json:"storage,omitempty"`
+}
+
+// PrestateDiff is the state difference as generated by the node's
+// prestateTracer in diff mode.  Pre contains the original values of modified
+// state, and post contains the new values.  Accounts that are present in pre
+// but not in post have been deleted, and storage slots that are present in pre
+// but not in post have been cleared.
+type PrestateDiff struct {
+	Pre  map[common.Address]*PrestateAccount `json:"pre"`
+	Post map[common.Address]*PrestateAccount `json:"post"`
+}
+
+// BalanceChange is a change in the balance of an account.
+type BalanceChange struct {
+	Address common.Address
+	Old     *big.Int
+	New     *big.Int
+}
+
+// StorageChange is a change in the value of a storage slot.
+type StorageChange struct {
+	Address common.Address
+	Slot    common.Hash
+	Old     common.Hash
+	New     common.Hash
+}
+
+// BalanceChanges returns the balance changes in the diff, ordered by address.
+func (d *PrestateDiff) BalanceChanges() []*BalanceChange {
+	res := make([]*BalanceChange, 0)
+	for _, address := range d.addresses() {
+		oldBalance := big.NewInt(0)
+		if pre, exists := d.Pre[address]; exists && pre.Balance != nil {
+			oldBalance = pre.Balance.ToInt()
+		}
+		newBalance := big.NewInt(0)
+		if post, exists := d.Post[address]; exists {
+			if post.Balance != nil {
+				newBalance = post.Balance.ToInt()
+			} else {
+				newBalance = oldBalance
+			}
+		}
+		if oldBalance.Cmp(newBalance) != 0 {
+			res = append(res, &BalanceChange{Address: address, Old: oldBalance, New: newBalance})
+		}
+	}
+	return res
+}
+
+// StorageChanges returns the storage changes in the diff, ordered by address
+// and slot.
+func (d *PrestateDiff) StorageChanges() []*StorageChange {
+	res := make([]*StorageChange, 0)
+	for _, address := range d.addresses() {
+		values := make(map[common.Hash]*StorageChange)
+		if pre, exists := d.Pre[address]; exists {
+			for slot, value := range pre.Storage {
+				values[slot] = &StorageChange{Address: address, Slot: slot, Old: value}
+			}
+		}
+		if post, exists := d.Post[address]; exists {
+			for slot, value := range post.Storage {
+				if _, exists := values[slot]; !exists {
+					values[slot] = &StorageChange{Address: address, Slot: slot}
+				}
+				values[slot].New = value
+			}
+		}
+		slots := make([]*StorageChange, 0, len(values))
+		for _, change := range values {
+			if change.Old != change.New {
+				slots = append(slots, change)
+			}
+		}
+		sort.Slice(slots, func(i, j int) bool {
+			return bytes.Compare(slots[i].Slot[:], slots[j].Slot[:]) < 0
+		})
+		res = append(res, slots...)
+	}
+	return res
+}
+
+// addresses returns all addresses in the diff, ordered.
+func (d *PrestateDiff) addresses() []common.Address {
+	seen := make(map[common.Address]bool)
+	res := make([]common.Address, 0)
+	for address := range d.Pre {
+		if !seen[address] {
+			seen[address] = true
+			res = append(res, address)
+		}
+	}
+	for address := range d.Post {
+		if !seen[address] {
+			seen[address] = true
+			res = append(res, address)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return bytes.Compare(res[i][:], res[j][:]) < 0
+	})
+	return res
+}
+
+// txCallArgs creates the arguments to simulate a transaction.
+func txCallArgs(from common.Address, tx *types.Transaction) map[string]interface{} {
+	args := map[string]interface{}{
+		"from":  from,
+		"gas":   hexutil.Uint64(tx.Gas()),
+		"value": (*hexutil.Big)(tx.Value()),
+		"nonce": hexutil.Uint64(tx.Nonce()),
+		"input": hexutil.Bytes(tx.Data()),
+	}
+	if tx.To() != nil {
+		args["to"] = tx.To()
+	}
+	if tx.Type() == types.DynamicFeeTxType {
+		args["maxFeePerGas"] = (*hexutil.Big)(tx.GasFeeCap())
+		args["maxPriorityFeePerGas"] = (*hexutil.Big)(tx.GasTipCap())
+	} else {
+		args["gasPrice"] = (*hexutil.Big)(tx.GasPrice())
+	}
+	if tx.Type() != types.LegacyTxType {
+		args["accessList"] = tx.AccessList()
+	}
+	return args
+}
+
+// SimulateTransaction simulates a transaction against the latest state,
+// returning the state changes and call trace.
+func SimulateTransaction(ctx context.Context, client *rpc.Client, from common.Address, tx *types.Transaction) (*PrestateDiff, *CallFrame, error) {
+	args := txCallArgs(from, tx)
+
+	frame := &CallFrame{}
+	if err := client.CallContext(ctx, frame, "debug_traceCall", args, "latest", map[string]interface{}{
+		"tracer": "callTracer",
+		"tracerConfig": map[string]interface{}{
+			"withLog": true,
+		},
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	diff := &PrestateDiff{}
+	if err := client.CallContext(ctx, diff, "debug_traceCall", args, "latest", map[string]interface{}{
+		"tracer": "prestateTracer",
+		"tracerConfig": map[string]interface{}{
+			"diffMode": true,
+		},
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	return diff, frame, nil
+}
@@ -0,0 +1,65 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrestateDiff(t *testing.T) {
+	input := `{
+  "pre": {
+    "0x2c7536e3605d9c16a7a3d7b1898e529396a65c23": {"balance": "0xde0b6b3a7640000", "nonce": 1},
+    "0x5ffc014343cd971b7eb70732021e26c35b744cc4": {"balance": "0x0", "code": "0x6080", "storage": {
+      "0x0000000000000000000000000000000000000000000000000000000000000001": "0x0000000000000000000000000000000000000000000000000000000000000005",
+      "0x0000000000000000000000000000000000000000000000000000000000000002": "0x0000000000000000000000000000000000000000000000000000000000000007"
+    }}
+  },
+  "post": {
+    "0x2c7536e3605d9c16a7a3d7b1898e529396a65c23": {"balance": "0x6f05b59d3b20000", "nonce": 2},
+    "0x5ffc014343cd971b7eb70732021e26c35b744cc4": {"storage": {
+      "0x0000000000000000000000000000000000000000000000000000000000000001": "0x0000000000000000000000000000000000000000000000000000000000000006",
+      "0x0000000000000000000000000000000000000000000000000000000000000003": "0x0000000000000000000000000000000000000000000000000000000000000001"
+    }}
+  }
+}`
+	diff := &PrestateDiff{}
+	require.Nil(t, json.Unmarshal([]byte(input), diff))
+
+	balances := diff.BalanceChanges()
+	require.Len(t, balances, 1)
+	assert.Equal(t, common.HexToAddress("0x2c7536e3605d9c16a7a3d7b1898e529396a65c23"), balances[0].Address)
+	assert.Equal(t, "1000000000000000000", balances[0].Old.String())
+	assert.Equal(t, "500000000000000000", balances[0].New.String())
+
+	storage := diff.StorageChanges()
+	require.Len(t, storage, 3)
+	// Changed
+	assert.Equal(t, common.HexToHash("0x01"), storage[0].Slot)
+	assert.Equal(t, common.HexToHash("0x05"), storage[0].Old)
+	assert.Equal(t, common.HexToHash("0x06"), storage[0].New)
+	// Cleared
+	assert.Equal(t, common.HexToHash("0x02"), storage[1].Slot)
+	assert.Equal(t, common.HexToHash("0x07"), storage[1].Old)
+	assert.Equal(t, common.Hash{}, storage[1].New)
+	// Created
+	assert.Equal(t, common.HexToHash("0x03"), storage[2].Slot)
+	assert.Equal(t, common.Hash{}, storage[2].Old)
+	assert.Equal(t, common.HexToHash("0x01"), storage[2].New)
+}
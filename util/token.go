@@ -0,0 +1,40 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	ens "github.com/wealdtech/go-ens/v3"
+)
+
+// TokenAddress obtains the address of a token contract.  The input can be an
+// address, an ENS name or the short name of a token, for example 'usdc', which
+// is resolved as {input}.thetoken.eth.
+func TokenAddress(backend bind.ContractBackend, input string) (common.Address, error) {
+	// Guess 1 - might be an ENS name or a hex string
+	address, err := ens.Resolve(backend, input)
+	if (address == (common.Address{}) || err != nil) && !strings.HasSuffix(input, ".eth") {
+		// Guess 2 - try {input}.thetoken.eth
+		address, err = ens.Resolve(backend, input+".thetoken.eth")
+		if err != nil {
+			// Give up
+			return common.Address{}, fmt.Errorf("Unknown token %s", input)
+		}
+	}
+	return address, err
+}
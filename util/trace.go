@@ -0,0 +1,85 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// CallFrame is a single frame of a call trace as generated by the node's
+// callTracer.
+type CallFrame struct {
+	Type         string          `json:"type"`
+	From         common.Address  `json:"from"`
+	To           *common.Address `json:"to,omitempty"`
+	Value        *hexutil.Big    `json:"value,omitempty"`
+	Gas          hexutil.Uint64  `json:"gas"`
+	GasUsed      hexutil.Uint64  `json:"gasUsed"`
+	Input        hexutil.Bytes   `json:"input"`
+	Output       hexutil.Bytes   `json:"output,omitempty"`
+	Error        string          `json:"error,omitempty"`
+	RevertReason string          `json:"revertReason,omitempty"`
+	Calls        []*CallFrame    `json:"calls,omitempty"`
+	Logs         []*CallLog      `json:"logs,omitempty"`
+}
+
+// CallLog is a log emitted by a call frame.  Logs are only present if the node
+// supports the callTracer's withLog option.
+type CallLog struct {
+	Address common.Address `json:"address"`
+	Topics  []common.Hash  `json:"topics"`
+	Data    hexutil.Bytes  `json:"data"`
+}
+
+// Log returns the call log as a standard log.
+func (l *CallLog) Log() *types.Log {
+	return &types.Log{
+		Address: l.Address,
+		Topics:  l.Topics,
+		Data:    l.Data,
+	}
+}
+
+// AllLogs returns the logs emitted by the frame and its children.  Logs are
+// grouped by frame so may not be in the order in which they were emitted.
+func (f *CallFrame) AllLogs() []*types.Log {
+	res := make([]*types.Log, 0)
+	for _, log := range f.Logs {
+		res = append(res, log.Log())
+	}
+	for _, call := range f.Calls {
+		res = append(res, call.AllLogs()...)
+	}
+	return res
+}
+
+// TraceTransaction obtains the call trace of a mined transaction.
+func TraceTransaction(ctx context.Context, client *rpc.Client, txHash common.Hash) (*CallFrame, error) {
+	res := &CallFrame{}
+	err := client.CallContext(ctx, res, "debug_traceTransaction", txHash, map[string]interface{}{
+		"tracer": "callTracer",
+		"tracerConfig": map[string]interface{}{
+			"withLog": true,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,67 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallFrameUnmarshal(t *testing.T) {
+	input := `{
+  "type": "CALL",
+  "from": "0x2c7536e3605d9c16a7a3d7b1898e529396a65c23",
+  "to": "0x5ffc014343cd971b7eb70732021e26c35b744cc4",
+  "value": "0xde0b6b3a7640000",
+  "gas": "0x7530",
+  "gasUsed": "0x5208",
+  "input": "0x",
+  "calls": [
+    {
+      "type": "DELEGATECALL",
+      "from": "0x5ffc014343cd971b7eb70732021e26c35b744cc4",
+      "to": "0x2ab7150bba7d5f181b3af5623e52b15bb1054845",
+      "gas": "0x1000",
+      "gasUsed": "0x100",
+      "input": "0x01020304",
+      "output": "0x08c379a0",
+      "error": "execution reverted",
+      "logs": [
+        {
+          "address": "0x5ffc014343cd971b7eb70732021e26c35b744cc4",
+          "topics": ["0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"],
+          "data": "0x"
+        }
+      ]
+    }
+  ]
+}`
+	frame := &CallFrame{}
+	require.Nil(t, json.Unmarshal([]byte(input), frame))
+	assert.Equal(t, "CALL", frame.Type)
+	assert.Equal(t, "1000000000000000000", frame.Value.ToInt().String())
+	assert.Equal(t, uint64(21000), uint64(frame.GasUsed))
+	require.Len(t, frame.Calls, 1)
+	child := frame.Calls[0]
+	assert.Equal(t, "DELEGATECALL", child.Type)
+	assert.Nil(t, child.Value)
+	assert.Equal(t, "execution reverted", child.Error)
+	assert.Equal(t, []byte{0x01, 0x02, 0x03, 0x04}, []byte(child.Input))
+	require.Len(t, child.Logs, 1)
+	assert.Equal(t, common.HexToAddress("0x5ffc014343cd971b7eb70732021e26c35b744cc4"), child.Logs[0].Log().Address)
+}
@@ -15,29 +15,229 @@ package util
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/spf13/viper"
 )
 
-// WaitForTransaction waits for the transaction to be mined, or for the limit to expire
-func WaitForTransaction(client *ethclient.Client, txHash common.Hash, limit time.Duration) bool {
-	start := time.Now()
-	first := true
-	for limit == 0 || time.Since(start) < limit {
-		if !first {
-			time.Sleep(5 * time.Second)
-		} else {
-			first = false
+// WaitForTransaction waits for the transaction to be mined with the given
+// number of confirmations, or for the limit to expire.  It returns the receipt
+// of the transaction.
+func WaitForTransaction(client *ethclient.Client, txHash common.Hash, confirmations uint64, limit time.Duration) (*types.Receipt, bool) {
+	return WaitForTransactions(client, []common.Hash{txHash}, confirmations, limit)
+}
+
+// WaitForTransactions waits for any of the transactions to be mined with the
+// given number of confirmations, or for the limit to expire.  It returns the
+// receipt of the transaction that was mined.  This is used when waiting for a
+// transaction that may have been replaced.
+//
+// If the connection supports subscriptions then the transactions are checked
+// with each new block, otherwise the node is polled.  If a chain
+// reorganisation removes a transaction from the block in which it was included
+// then its confirmations are counted from the new inclusion block, if any.
+func WaitForTransactions(client *ethclient.Client, txHashes []common.Hash, confirmations uint64, limit time.Duration) (*types.Receipt, bool) {
+	if confirmations == 0 {
+		confirmations = 1
+	}
+
+	var timeout <-chan time.Time
+	if limit != 0 {
+		timer := time.NewTimer(limit)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	var ticker *time.Ticker
+	defer func() {
+		if ticker != nil {
+			ticker.Stop()
+		}
+	}()
+	var tick <-chan time.Time
+	heads := make(chan *types.Header, 16)
+	var headsErr <-chan error
+	sub, err := client.SubscribeNewHead(context.Background(), heads)
+	if err == nil {
+		defer sub.Unsubscribe()
+		headsErr = sub.Err()
+	} else {
+		// Connection does not support subscriptions
+		ticker = time.NewTicker(5 * time.Second)
+		tick = ticker.C
+	}
+
+	for {
+		if receipt := confirmedReceipt(client, txHashes, confirmations); receipt != nil {
+			return receipt, true
+		}
+		select {
+		case <-timeout:
+			return nil, false
+		case <-heads:
+		case <-tick:
+		case <-headsErr:
+			// Subscription failed; fall back to polling
+			headsErr = nil
+			ticker = time.NewTicker(5 * time.Second)
+			tick = ticker.C
 		}
-		ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration("timeout"))
-		defer cancel()
-		_, pending, err := client.TransactionByHash(ctx, txHash)
-		if err == nil && !pending {
-			return true
+	}
+}
+
+// confirmedReceipt returns the receipt for the first of the transactions that
+// is in the canonical chain with the required number of confirmations.
+func confirmedReceipt(client *ethclient.Client, txHashes []common.Hash, confirmations uint64) *types.Receipt {
+	ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration("timeout"))
+	defer cancel()
+	var head *types.Header
+	for _, txHash := range txHashes {
+		receipt, err := client.TransactionReceipt(ctx, txHash)
+		if err != nil || receipt == nil || receipt.BlockNumber == nil {
+			// Not mined, or removed by a reorganisation
+			continue
+		}
+		if head == nil {
+			head, err = client.HeaderByNumber(ctx, nil)
+			if err != nil {
+				return nil
+			}
+		}
+		if head.Number.Cmp(receipt.BlockNumber) < 0 {
+			// Node has yet to catch up
+			continue
+		}
+		if new(big.Int).Sub(head.Number, receipt.BlockNumber).Uint64()+1 < confirmations {
+			continue
+		}
+		// Ensure that the inclusion block is still canonical
+		header, err := client.HeaderByNumber(ctx, receipt.BlockNumber)
+		if err != nil || header.Hash() != receipt.BlockHash {
+			continue
+		}
+		return receipt
+	}
+	return nil
+}
+
+// MinReplacementFee returns the minimum fee required to replace a transaction
+// paying the given fee, which is just over 10% higher.
+func MinReplacementFee(fee *big.Int) *big.Int {
+	return new(big.Int).Add(new(big.Int).Add(fee, new(big.Int).Div(fee, big.NewInt(10))), big.NewInt(1))
+}
+
+// EscalateTransaction creates a replacement for the supplied transaction with
+// fees increased by the given percentage, or the minimum required for
+// replacement if higher.  If maxFee is not nil the gas price or max fee per gas
+// of the replacement will not exceed it; an error is returned if this does not
+// allow the fees to be increased enough to replace the transaction.
+func EscalateTransaction(tx *types.Transaction, chainID *big.Int, step uint64, maxFee *big.Int) (*types.Transaction, error) {
+	bump := func(fee *big.Int) *big.Int {
+		res := new(big.Int).Div(new(big.Int).Mul(fee, new(big.Int).SetUint64(100+step)), big.NewInt(100))
+		if min := MinReplacementFee(fee); res.Cmp(min) < 0 {
+			res = min
+		}
+		if maxFee != nil && res.Cmp(maxFee) > 0 {
+			res = new(big.Int).Set(maxFee)
+		}
+		return res
+	}
+
+	switch tx.Type() {
+	case types.DynamicFeeTxType:
+		feeCap := bump(tx.GasFeeCap())
+		tipCap := bump(tx.GasTipCap())
+		if tipCap.Cmp(feeCap) > 0 {
+			tipCap = feeCap
+		}
+		if feeCap.Cmp(MinReplacementFee(tx.GasFeeCap())) < 0 || tipCap.Cmp(MinReplacementFee(tx.GasTipCap())) < 0 {
+			return nil, errors.New("fees cannot be increased further")
+		}
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:    chainID,
+			Nonce:      tx.Nonce(),
+			GasTipCap:  tipCap,
+			GasFeeCap:  feeCap,
+			Gas:        tx.Gas(),
+			To:         tx.To(),
+			Value:      tx.Value(),
+			Data:       tx.Data(),
+			AccessList: tx.AccessList(),
+		}), nil
+	case types.AccessListTxType, types.LegacyTxType:
+		gasPrice := bump(tx.GasPrice())
+		if gasPrice.Cmp(MinReplacementFee(tx.GasPrice())) < 0 {
+			return nil, errors.New("gas price cannot be increased further")
+		}
+		if tx.Type() == types.AccessListTxType {
+			return types.NewTx(&types.AccessListTx{
+				ChainID:    chainID,
+				Nonce:      tx.Nonce(),
+				GasPrice:   gasPrice,
+				Gas:        tx.Gas(),
+				To:         tx.To(),
+				Value:      tx.Value(),
+				Data:       tx.Data(),
+				AccessList: tx.AccessList(),
+			}), nil
+		}
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    tx.Nonce(),
+			GasPrice: gasPrice,
+			Gas:      tx.Gas(),
+			To:       tx.To(),
+			Value:    tx.Value(),
+			Data:     tx.Data(),
+		}), nil
+	default:
+		return nil, fmt.Errorf("unsupported transaction type %d", tx.Type())
+	}
+}
+
+// RevertData obtains the data returned by a reverted call from the error
+// returned by the node, if present.
+func RevertData(err error) ([]byte, bool) {
+	dataErr, isDataErr := err.(rpc.DataError)
+	if !isDataErr {
+		return nil, false
+	}
+	dataStr, isString := dataErr.ErrorData().(string)
+	if !isString {
+		return nil, false
+	}
+	data, err := hexutil.Decode(dataStr)
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+// PoolNonces returns the nonces of the transactions from the given address
+// that are in the node's transaction pool, both pending and queued.
+func PoolNonces(ctx context.Context, client *rpc.Client, address common.Address) (map[uint64]bool, error) {
+	content := make(map[string]map[string]json.RawMessage)
+	if err := client.CallContext(ctx, &content, "txpool_contentFrom", address); err != nil {
+		return nil, err
+	}
+	res := make(map[uint64]bool)
+	for _, txs := range content {
+		for nonceStr := range txs {
+			nonce, err := strconv.ParseUint(nonceStr, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid nonce %q in transaction pool", nonceStr)
+			}
+			res[nonce] = true
 		}
 	}
-	return false
+	return res, nil
 }
@@ -0,0 +1,101 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMinReplacementFee(t *testing.T) {
+	assert.Equal(t, big.NewInt(111), MinReplacementFee(big.NewInt(100)))
+	assert.Equal(t, big.NewInt(1), MinReplacementFee(big.NewInt(0)))
+}
+
+func TestEscalateTransaction(t *testing.T) {
+	to := common.HexToAddress("0x5FfC014343cd971B7eb70732021E26C35B744cc4")
+	chainID := big.NewInt(1)
+	legacy := types.NewTx(&types.LegacyTx{Nonce: 3, GasPrice: big.NewInt(1000), Gas: 21000, To: &to, Value: big.NewInt(1)})
+	dynamic := types.NewTx(&types.DynamicFeeTx{ChainID: chainID, Nonce: 3, GasTipCap: big.NewInt(100), GasFeeCap: big.NewInt(1000), Gas: 21000, To: &to, Value: big.NewInt(1)})
+
+	tests := []struct {
+		tx       *types.Transaction
+		step     uint64
+		maxFee   *big.Int
+		gasPrice *big.Int
+		feeCap   *big.Int
+		tipCap   *big.Int
+		err      bool
+	}{
+		{ // 0 - legacy step
+			tx:       legacy,
+			step:     20,
+			gasPrice: big.NewInt(1200),
+		},
+		{ // 1 - legacy step below minimum
+			tx:       legacy,
+			step:     5,
+			gasPrice: big.NewInt(1101),
+		},
+		{ // 2 - legacy capped
+			tx:       legacy,
+			step:     50,
+			maxFee:   big.NewInt(1200),
+			gasPrice: big.NewInt(1200),
+		},
+		{ // 3 - legacy cap too low
+			tx:     legacy,
+			step:   50,
+			maxFee: big.NewInt(1050),
+			err:    true,
+		},
+		{ // 4 - dynamic step
+			tx:     dynamic,
+			step:   20,
+			feeCap: big.NewInt(1200),
+			tipCap: big.NewInt(120),
+		},
+		{ // 5 - dynamic cap too low
+			tx:     dynamic,
+			step:   20,
+			maxFee: big.NewInt(1000),
+			err:    true,
+		},
+	}
+
+	for i, tt := range tests {
+		tx, err := EscalateTransaction(tt.tx, chainID, tt.step, tt.maxFee)
+		if tt.err {
+			assert.NotNil(t, err, fmt.Sprintf("missing expected error at test %d", i))
+			continue
+		}
+		require.Nil(t, err, fmt.Sprintf("unexpected error at test %d", i))
+		assert.Equal(t, tt.tx.Type(), tx.Type(), fmt.Sprintf("incorrect type at test %d", i))
+		assert.Equal(t, tt.tx.Nonce(), tx.Nonce(), fmt.Sprintf("incorrect nonce at test %d", i))
+		assert.Equal(t, tt.tx.Value(), tx.Value(), fmt.Sprintf("incorrect value at test %d", i))
+		if tt.gasPrice != nil {
+			assert.Equal(t, tt.gasPrice, tx.GasPrice(), fmt.Sprintf("incorrect gas price at test %d", i))
+		}
+		if tt.feeCap != nil {
+			assert.Equal(t, tt.feeCap, tx.GasFeeCap(), fmt.Sprintf("incorrect fee cap at test %d", i))
+			assert.Equal(t, tt.tipCap, tx.GasTipCap(), fmt.Sprintf("incorrect tip cap at test %d", i))
+		}
+	}
+}
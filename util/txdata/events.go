@@ -20,6 +20,7 @@ func initEventMap() {
 	AddEventSignature("Allowance(address,address,address,uint256)")
 	AddEventSignature("AllowanceSet(address,address,address,uint256)")
 	AddEventSignature("Approval(address,address,uint256)")
+	AddEventSignature("ApprovalForAll(address,address,bool)")
 	AddEventSignature("AuthorizedOperator(address,address)")
 	AddEventSignature("Burn(address,uint256)")
 	AddEventSignature("Burned(address,address,uint256,bytes,bytes)")
@@ -68,6 +69,8 @@ func initEventMap() {
 	AddEventSignature("TokenRemoved(address,address)")
 	AddEventSignature("Transfer(address,address,uint256)")
 	AddEventSignature("Transfer(bytes32,address")
+	AddEventSignature("TransferBatch(address,address,address,uint256[],uint256[])")
+	AddEventSignature("TransferSingle(address,address,address,uint256,uint256)")
 	AddEventSignature("Unpause()")
 	AddEventSignature("Updated(bytes32,bytes,uint16)")
 	AddEventSignature("Updated(bytes32,bytes,uint16,uint256)")
@@ -0,0 +1,79 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txdata
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventToString(t *testing.T) {
+	resetSignatures(t)
+	initEventMap()
+
+	operator := common.HexToHash("0x0000000000000000000000005ffc014343cd971b7eb70732021e26c35b744cc4")
+	from := common.HexToHash("0x000000000000000000000000388ea662ef2c223ec0b047d41bf3c0f362142ad5")
+	to := common.HexToHash("0x00000000000000000000000052f1a3027d3aa514f17e454c93ae1f79b3b12d5d")
+
+	tests := []struct {
+		log    *types.Log
+		output string
+	}{
+		{ // 0 - unknown
+			log: &types.Log{
+				Topics: []common.Hash{crypto.Keccak256Hash([]byte("Unknown(address)")), operator},
+			},
+			output: "",
+		},
+		{ // 1 - TransferSingle
+			log: &types.Log{
+				Topics: []common.Hash{crypto.Keccak256Hash([]byte("TransferSingle(address,address,address,uint256,uint256)")), operator, from, to},
+				Data:   _hex("0x0000000000000000000000000000000000000000000000000000000000000007000000000000000000000000000000000000000000000000000000000000000a"),
+			},
+			output: "TransferSingle(0x5FfC014343cd971B7eb70732021E26C35B744cc4,0x388Ea662EF2c223eC0B047D41Bf3c0f362142ad5,0x52f1A3027d3aA514F17E454C93ae1F79b3B12d5d,7,10)",
+		},
+		{ // 2 - TransferBatch
+			log: &types.Log{
+				Topics: []common.Hash{crypto.Keccak256Hash([]byte("TransferBatch(address,address,address,uint256[],uint256[])")), operator, from, to},
+				Data: _hex("0x" +
+					"0000000000000000000000000000000000000000000000000000000000000040" +
+					"00000000000000000000000000000000000000000000000000000000000000a0" +
+					"0000000000000000000000000000000000000000000000000000000000000002" +
+					"0000000000000000000000000000000000000000000000000000000000000001" +
+					"0000000000000000000000000000000000000000000000000000000000000002" +
+					"0000000000000000000000000000000000000000000000000000000000000002" +
+					"000000000000000000000000000000000000000000000000000000000000000a" +
+					"0000000000000000000000000000000000000000000000000000000000000014"),
+			},
+			output: "TransferBatch(0x5FfC014343cd971B7eb70732021E26C35B744cc4,0x388Ea662EF2c223eC0B047D41Bf3c0f362142ad5,0x52f1A3027d3aA514F17E454C93ae1F79b3B12d5d,[1,2],[10,20])",
+		},
+		{ // 3 - ApprovalForAll
+			log: &types.Log{
+				Topics: []common.Hash{crypto.Keccak256Hash([]byte("ApprovalForAll(address,address,bool)")), from, operator},
+				Data:   _hex("0x0000000000000000000000000000000000000000000000000000000000000001"),
+			},
+			output: "ApprovalForAll(0x388Ea662EF2c223eC0B047D41Bf3c0f362142ad5,0x5FfC014343cd971B7eb70732021E26C35B744cc4,true)",
+		},
+	}
+
+	for i, tt := range tests {
+		output := EventToString(nil, tt.log)
+		assert.Equal(t, tt.output, output, fmt.Sprintf("incorrect output at test %d", i))
+	}
+}
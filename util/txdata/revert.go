@@ -0,0 +1,78 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txdata
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+var panicSelector = []byte{0x4e, 0x48, 0x7b, 0x71}
+
+// panicReasons are the reasons for Solidity Panic(uint256) codes
+var panicReasons = map[uint64]string{
+	0x00: "generic compiler panic",
+	0x01: "assertion failed",
+	0x11: "arithmetic overflow or underflow",
+	0x12: "division or modulo by zero",
+	0x21: "invalid enum value",
+	0x22: "invalid storage byte array encoding",
+	0x31: "pop on empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to invalid internal function",
+}
+
+// RevertToString takes the data returned by a reverted call and converts it to
+// a useful representation.  Error(string) and Panic(uint256) are always
+// decoded; custom errors are decoded if they are present in the supplied ABI
+// (which can be nil) or the function map.
+func RevertToString(client *ethclient.Client, contractAbi *abi.ABI, data []byte) string {
+	if len(data) == 0 {
+		return ""
+	}
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return reason
+	}
+	if len(data) == 36 && bytes.Equal(data[:4], panicSelector) {
+		code := new(big.Int).SetBytes(data[4:])
+		reason, exists := panicReasons[code.Uint64()]
+		if !code.IsUint64() || !exists {
+			reason = "unknown panic"
+		}
+		return fmt.Sprintf("Panic(0x%x): %s", code, reason)
+	}
+	if contractAbi != nil && len(data) >= 4 {
+		for _, abiErr := range contractAbi.Errors {
+			if !bytes.Equal(data[:4], abiErr.ID[:4]) {
+				continue
+			}
+			unpacked, err := abiErr.Unpack(data)
+			if err != nil {
+				break
+			}
+			values := make([]string, 0)
+			for _, value := range unpacked.([]interface{}) {
+				values = append(values, fmt.Sprintf("%v", value))
+			}
+			return fmt.Sprintf("%s(%s)", abiErr.Name, strings.Join(values, ","))
+		}
+	}
+	return DataToString(client, data)
+}
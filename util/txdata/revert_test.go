@@ -0,0 +1,94 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txdata
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func _hex(input string) []byte {
+	res, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// resetSignatures clears the known signatures for the duration of a test.
+func resetSignatures(t *testing.T) {
+	savedFunctions := functions
+	savedEvents := events
+	t.Cleanup(func() {
+		functions = savedFunctions
+		events = savedEvents
+	})
+	functions = make(map[[4]byte]function)
+	events = make(map[[32]byte]function)
+}
+
+func TestRevertToString(t *testing.T) {
+	contractAbi, err := abi.JSON(strings.NewReader(`[{"type":"error","name":"InsufficientBalance","inputs":[{"name":"available","type":"uint256"},{"name":"required","type":"uint256"}]}]`))
+	require.Nil(t, err)
+
+	resetSignatures(t)
+	AddFunctionSignature("Unauthorized(address)")
+
+	tests := []struct {
+		data   []byte
+		abi    *abi.ABI
+		output string
+	}{
+		{ // 0 - empty
+			data:   []byte{},
+			output: "",
+		},
+		{ // 1 - Error(string)
+			data:   _hex("0x08c379a00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000b6e6f7420616c6c6f776564000000000000000000000000000000000000000000"),
+			output: "not allowed",
+		},
+		{ // 2 - Panic(uint256)
+			data:   _hex("0x4e487b710000000000000000000000000000000000000000000000000000000000000011"),
+			output: "Panic(0x11): arithmetic overflow or underflow",
+		},
+		{ // 3 - Panic(uint256) unknown code
+			data:   _hex("0x4e487b710000000000000000000000000000000000000000000000000000000000000099"),
+			output: "Panic(0x99): unknown panic",
+		},
+		{ // 4 - custom error from ABI
+			data:   append(_hex("0xcf479181"), _hex("0x00000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000002")...),
+			abi:    &contractAbi,
+			output: "InsufficientBalance(1,2)",
+		},
+		{ // 5 - custom error from signatures
+			data:   _hex("0x8e4a23d60000000000000000000000005ffc014343cd971b7eb70732021e26c35b744cc4"),
+			output: "Unauthorized(0x5FfC014343cd971B7eb70732021E26C35B744cc4)",
+		},
+		{ // 6 - unknown
+			data:   _hex("0x01020304"),
+			output: "0x01020304",
+		},
+	}
+
+	for i, tt := range tests {
+		output := RevertToString(nil, tt.abi, tt.data)
+		assert.Equal(t, tt.output, output, fmt.Sprintf("incorrect output at test %d", i))
+	}
+}
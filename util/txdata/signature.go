@@ -24,7 +24,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
-	ens "github.com/wealdtech/go-ens/v2"
+	ens "github.com/wealdtech/go-ens/v3"
 	"golang.org/x/crypto/sha3"
 )
 
@@ -53,7 +53,7 @@ func DataToString(client *ethclient.Client, input []byte) string {
 	var buffer bytes.Buffer
 	buffer.WriteString(fmt.Sprintf("%s(", function.name))
 	for i, param := range function.params {
-		t, err := abi.NewType(param, nil)
+		t, err := abi.NewType(param, "", nil)
 		if err == nil {
 			res, err := contractValueToString(client, t, uint32(i), input)
 			if err != nil {
@@ -86,7 +86,7 @@ func EventToString(client *ethclient.Client, input *types.Log) string {
 
 	curTopic := 1
 	for i, param := range function.params {
-		t, err := abi.NewType(param, nil)
+		t, err := abi.NewType(param, "", nil)
 		if err == nil {
 			var res string
 			var err error
@@ -142,6 +142,9 @@ func valueToString(client *ethclient.Client, argType abi.Type, index uint32, off
 		return "[" + strings.Join(res, ",") + "]", nil
 	case abi.AddressTy:
 		address := common.BytesToAddress(data[offset+index*32+12 : offset+index*32+32])
+		if client == nil {
+			return address.Hex(), nil
+		}
 		return ens.Format(client, address), nil
 	case abi.FixedBytesTy:
 		return fmt.Sprintf("0x%x", data[offset+index*32+32-uint32(argType.Size):offset+index*32+32]), nil
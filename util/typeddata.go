@@ -0,0 +1,143 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// typedDataDomainFields are the fields of the EIP-712 domain, in the order
+// in which they are defined if the document does not define them.
+var typedDataDomainFields = []apitypes.Type{
+	{Name: "name", Type: "string"},
+	{Name: "version", Type: "string"},
+	{Name: "chainId", Type: "uint256"},
+	{Name: "verifyingContract", Type: "address"},
+	{Name: "salt", Type: "bytes32"},
+}
+
+// ParseTypedData parses an EIP-712 typed structured data document of the
+// form {types, primaryType, domain, message}.  If the document does not
+// define the EIP712Domain type it is built from the fields present in the
+// domain.  Numbers in the domain and message are passed on as strings, so
+// that large integers do not lose precision.
+func ParseTypedData(input []byte) (*apitypes.TypedData, error) {
+	document := make(map[string]interface{})
+	decoder := json.NewDecoder(bytes.NewReader(input))
+	decoder.UseNumber()
+	if err := decoder.Decode(&document); err != nil {
+		return nil, fmt.Errorf("invalid typed data: %v", err)
+	}
+	for _, key := range []string{"domain", "message"} {
+		if value, exists := document[key]; exists {
+			document[key] = typedDataNumbersToStrings(value)
+		}
+	}
+	normalised, err := json.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+	typedData := &apitypes.TypedData{}
+	if err := json.Unmarshal(normalised, typedData); err != nil {
+		return nil, fmt.Errorf("invalid typed data: %v", err)
+	}
+
+	if typedData.Types == nil {
+		return nil, errors.New("typed data has no types")
+	}
+	if typedData.PrimaryType == "" {
+		return nil, errors.New("typed data has no primary type")
+	}
+	if _, exists := typedData.Types[typedData.PrimaryType]; !exists {
+		return nil, fmt.Errorf("primary type %s is not defined", typedData.PrimaryType)
+	}
+	if typedData.Message == nil {
+		typedData.Message = make(apitypes.TypedDataMessage)
+	}
+	if _, exists := typedData.Types["EIP712Domain"]; !exists {
+		domain := typedData.Domain.Map()
+		fields := make([]apitypes.Type, 0)
+		for _, field := range typedDataDomainFields {
+			if _, exists := domain[field.Name]; exists {
+				fields = append(fields, field)
+			}
+		}
+		typedData.Types["EIP712Domain"] = fields
+	}
+	return typedData, nil
+}
+
+// typedDataNumbersToStrings replaces the numbers in a decoded JSON value with
+// their string representation.
+func typedDataNumbersToStrings(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		return v.String()
+	case []interface{}:
+		for i := range v {
+			v[i] = typedDataNumbersToStrings(v[i])
+		}
+	case map[string]interface{}:
+		for name := range v {
+			v[name] = typedDataNumbersToStrings(v[name])
+		}
+	}
+	return value
+}
+
+// TypedDataDomainSeparator returns the hash of the domain of typed data.
+func TypedDataDomainSeparator(typedData *apitypes.TypedData) (common.Hash, error) {
+	hash, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to hash domain: %v", err)
+	}
+	return common.BytesToHash(hash), nil
+}
+
+// TypedDataHash returns the hash to sign for typed data, being the hash of
+// "\x19\x01" ‖ domainSeparator ‖ hashStruct(message).
+func TypedDataHash(typedData *apitypes.TypedData) (common.Hash, error) {
+	hash, _, err := apitypes.TypedDataAndHash(*typedData)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(hash), nil
+}
+
+// ExternalSignTypedData signs typed data with the account_signTypedData API
+// of an external signer such as Clef.  The signature is returned with a V of
+// 0 or 1, as for local signatures.
+func ExternalSignTypedData(ctx context.Context, client *rpc.Client, address common.Address, typedData json.RawMessage) ([]byte, error) {
+	var signature hexutil.Bytes
+	signAddress := common.NewMixedcaseAddress(address)
+	if err := client.CallContext(ctx, &signature, "account_signTypedData", &signAddress, typedData); err != nil {
+		return nil, err
+	}
+	if len(signature) != 65 {
+		return nil, fmt.Errorf("invalid signature length %d", len(signature))
+	}
+	if signature[64] == 27 || signature[64] == 28 {
+		signature[64] -= 27
+	}
+	return signature, nil
+}
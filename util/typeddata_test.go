@@ -0,0 +1,251 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// eip712Mail is the example from EIP-712.
+var eip712Mail = `{
+  "types": {
+    "EIP712Domain": [
+      {"name": "name", "type": "string"},
+      {"name": "version", "type": "string"},
+      {"name": "chainId", "type": "uint256"},
+      {"name": "verifyingContract", "type": "address"}
+    ],
+    "Person": [
+      {"name": "name", "type": "string"},
+      {"name": "wallet", "type": "address"}
+    ],
+    "Mail": [
+      {"name": "from", "type": "Person"},
+      {"name": "to", "type": "Person"},
+      {"name": "contents", "type": "string"}
+    ]
+  },
+  "primaryType": "Mail",
+  "domain": {
+    "name": "Ether Mail",
+    "version": "1",
+    "chainId": 1,
+    "verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"
+  },
+  "message": {
+    "from": {"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"},
+    "to": {"name": "Bob", "wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"},
+    "contents": "Hello, Bob!"
+  }
+}`
+
+// eip712MailArrays is the example from EIP-712 extended with arrays.
+var eip712MailArrays = `{
+  "types": {
+    "EIP712Domain": [
+      {"name": "name", "type": "string"},
+      {"name": "version", "type": "string"},
+      {"name": "chainId", "type": "uint256"},
+      {"name": "verifyingContract", "type": "address"}
+    ],
+    "Person": [
+      {"name": "name", "type": "string"},
+      {"name": "wallets", "type": "address[]"}
+    ],
+    "Mail": [
+      {"name": "from", "type": "Person"},
+      {"name": "to", "type": "Person[]"},
+      {"name": "contents", "type": "string"},
+      {"name": "tags", "type": "bytes32[]"}
+    ]
+  },
+  "primaryType": "Mail",
+  "domain": {
+    "name": "Ether Mail",
+    "version": "1",
+    "chainId": "1",
+    "verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"
+  },
+  "message": {
+    "from": {"name": "Cow", "wallets": ["0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826", "0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF"]},
+    "to": [
+      {"name": "Bob", "wallets": ["0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB", "0xB0BdaBea57B0BDABeA57b0bdABEA57b0BDabEa57", "0xB0B0b0b0b0b0B000000000000000000000000000"]}
+    ],
+    "contents": "Hello, Bob!",
+    "tags": ["0x0000000000000000000000000000000000000000000000000000000000000001", "0x0000000000000000000000000000000000000000000000000000000000000002"]
+  }
+}`
+
+func TestTypedDataEIP712(t *testing.T) {
+	typedData, err := ParseTypedData([]byte(eip712Mail))
+	require.Nil(t, err)
+
+	messageHash, err := typedData.HashStruct("Mail", typedData.Message)
+	require.Nil(t, err)
+	assert.Equal(t, "0xc52c0ee5d84264471806290a3f2c4cecfc5490626bf912d01f240d7a274b371e", messageHash.String())
+
+	domainSeparator, err := TypedDataDomainSeparator(typedData)
+	require.Nil(t, err)
+	assert.Equal(t, "0xf2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f", domainSeparator.Hex())
+
+	hash, err := TypedDataHash(typedData)
+	require.Nil(t, err)
+	assert.Equal(t, "0xbe609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2", hash.Hex())
+
+	// Signature from the EIP, signed with the key keccak256("cow").
+	key, err := crypto.ToECDSA(crypto.Keccak256([]byte("cow")))
+	require.Nil(t, err)
+	signature, err := crypto.Sign(hash.Bytes(), key)
+	require.Nil(t, err)
+	assert.Equal(t, "4355c47d63924e8a72e509b65029052eb6c299d53a04e167c5775fd466751c9d", fmt.Sprintf("%x", signature[0:32]))
+	assert.Equal(t, "07299936d304c153f6443dfa05f40ff007d72911b6f72307f996231605b91562", fmt.Sprintf("%x", signature[32:64]))
+	assert.Equal(t, byte(28), signature[64]+27)
+
+	signer, err := crypto.SigToPub(hash.Bytes(), signature)
+	require.Nil(t, err)
+	assert.Equal(t, common.HexToAddress("0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"), crypto.PubkeyToAddress(*signer))
+}
+
+func TestTypedDataArrays(t *testing.T) {
+	typedData, err := ParseTypedData([]byte(eip712MailArrays))
+	require.Nil(t, err)
+	hash, err := TypedDataHash(typedData)
+	require.Nil(t, err)
+
+	// Parsing should not alter the document as understood by go-ethereum.
+	var reference apitypes.TypedData
+	require.Nil(t, json.Unmarshal([]byte(eip712MailArrays), &reference))
+	referenceHash, _, err := apitypes.TypedDataAndHash(reference)
+	require.Nil(t, err)
+	assert.Equal(t, common.BytesToHash(referenceHash), hash)
+}
+
+func TestParseTypedData(t *testing.T) {
+	typedData, err := ParseTypedData([]byte(`{
+  "types": {
+    "Test": [
+      {"name": "value", "type": "uint256"}
+    ]
+  },
+  "primaryType": "Test",
+  "domain": {"name": "Test", "chainId": 5},
+  "message": {"value": 115792089237316195423570985008687907853269984665640564039457584007913129639935}
+}`))
+	require.Nil(t, err)
+
+	// EIP712Domain is built from the fields present in the domain.
+	assert.Equal(t, []apitypes.Type{{Name: "name", Type: "string"}, {Name: "chainId", Type: "uint256"}}, typedData.Types["EIP712Domain"])
+	assert.Equal(t, big.NewInt(5), (*big.Int)(typedData.Domain.ChainId))
+
+	// Large integers retain their precision.
+	messageHash, err := typedData.HashStruct("Test", typedData.Message)
+	require.Nil(t, err)
+	expected := crypto.Keccak256(
+		crypto.Keccak256([]byte("Test(uint256 value)")),
+		math.U256Bytes(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))),
+	)
+	assert.Equal(t, expected, []byte(messageHash))
+
+	domainSeparator, err := TypedDataDomainSeparator(typedData)
+	require.Nil(t, err)
+	assert.Equal(t, crypto.Keccak256Hash(
+		crypto.Keccak256([]byte("EIP712Domain(string name,uint256 chainId)")),
+		crypto.Keccak256([]byte("Test")),
+		math.U256Bytes(big.NewInt(5)),
+	), domainSeparator)
+}
+
+func TestTypedDataErrors(t *testing.T) {
+	tests := []struct {
+		types   string
+		message string
+		err     string
+	}{
+		{ // 0
+			types:   `{"Test": [{"name": "value", "type": "uint256"}]}`,
+			message: `{"value": 1, "other": 2}`,
+			err:     "there is extra data provided in the message (1 < 2)",
+		},
+		{ // 1
+			types:   `{"Test": [{"name": "value", "type": "uint8"}]}`,
+			message: `{"value": 256}`,
+			err:     "integer larger than 'uint8'",
+		},
+		{ // 2
+			types:   `{"Test": [{"name": "value", "type": "Unknown"}]}`,
+			message: `{"value": 1}`,
+			err:     "reference type \"Unknown\" is undefined",
+		},
+	}
+
+	for i, test := range tests {
+		typedData, err := ParseTypedData([]byte(fmt.Sprintf(`{"types": %s, "primaryType": "Test", "domain": {"name": "Test"}, "message": %s}`, test.types, test.message)))
+		require.Nil(t, err, fmt.Sprintf("failed to parse typed data at test %d", i))
+		_, err = TypedDataHash(typedData)
+		assert.EqualError(t, err, test.err, fmt.Sprintf("incorrect error at test %d", i))
+	}
+}
+
+func TestParseTypedDataErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		err   string
+	}{
+		{ // 0
+			input: `{`,
+			err:   "invalid typed data: unexpected EOF",
+		},
+		{ // 1
+			input: `{"primaryType": "Test"}`,
+			err:   "typed data has no types",
+		},
+		{ // 2
+			input: `{"types": {"Test": []}}`,
+			err:   "typed data has no primary type",
+		},
+		{ // 3
+			input: `{"types": {"Test": []}, "primaryType": "Other"}`,
+			err:   "primary type Other is not defined",
+		},
+	}
+
+	for i, test := range tests {
+		_, err := ParseTypedData([]byte(test.input))
+		assert.EqualError(t, err, test.err, fmt.Sprintf("incorrect error at test %d", i))
+	}
+}
+
+func TestExternalSignTypedData(t *testing.T) {
+	key, err := crypto.ToECDSA(crypto.Keccak256([]byte("cow")))
+	require.Nil(t, err)
+	client, err := rpc.Dial(startStubSigner(t, &stubSigner{key: key}))
+	require.Nil(t, err)
+	defer client.Close()
+
+	signature, err := ExternalSignTypedData(context.Background(), client, crypto.PubkeyToAddress(key.PublicKey), json.RawMessage(eip712Mail))
+	require.Nil(t, err)
+	assert.Equal(t, "4355c47d63924e8a72e509b65029052eb6c299d53a04e167c5775fd466751c9d07299936d304c153f6443dfa05f40ff007d72911b6f72307f996231605b9156201", fmt.Sprintf("%x", signature))
+}
@@ -0,0 +1,172 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// UnsignedTransaction is a self-describing unsigned transaction, suitable for
+// passing to an offline machine for signing.  Monetary values are decimal
+// strings in Wei.
+type UnsignedTransaction struct {
+	Type                 uint8            `json:"type"`
+	ChainID              string           `json:"chainId"`
+	From                 common.Address   `json:"from"`
+	Nonce                uint64           `json:"nonce"`
+	Gas                  uint64           `json:"gas"`
+	GasPrice             string           `json:"gasPrice,omitempty"`
+	MaxFeePerGas         string           `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas string           `json:"maxPriorityFeePerGas,omitempty"`
+	To                   *common.Address  `json:"to,omitempty"`
+	Value                string           `json:"value"`
+	Data                 hexutil.Bytes    `json:"data,omitempty"`
+	AccessList           types.AccessList `json:"accessList,omitempty"`
+	Call                 string           `json:"call,omitempty"`
+}
+
+// NewUnsignedTransaction creates an unsigned transaction from a transaction.
+// call is an optional human-readable representation of the transaction data.
+func NewUnsignedTransaction(from common.Address, chainID *big.Int, tx *types.Transaction, call string) *UnsignedTransaction {
+	res := &UnsignedTransaction{
+		Type:       tx.Type(),
+		ChainID:    chainID.String(),
+		From:       from,
+		Nonce:      tx.Nonce(),
+		Gas:        tx.Gas(),
+		To:         tx.To(),
+		Value:      tx.Value().String(),
+		Data:       tx.Data(),
+		AccessList: tx.AccessList(),
+		Call:       call,
+	}
+	if tx.Type() == types.DynamicFeeTxType {
+		res.MaxFeePerGas = tx.GasFeeCap().String()
+		res.MaxPriorityFeePerGas = tx.GasTipCap().String()
+	} else {
+		res.GasPrice = tx.GasPrice().String()
+	}
+	return res
+}
+
+// ParseUnsignedTransaction parses an unsigned transaction.  The input can
+// either be the JSON transaction itself or the path to a file containing it.
+func ParseUnsignedTransaction(input string) (*UnsignedTransaction, error) {
+	var data []byte
+	if strings.HasPrefix(strings.TrimSpace(input), "{") {
+		data = []byte(input)
+	} else {
+		var err error
+		data, err = ioutil.ReadFile(input)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res := &UnsignedTransaction{}
+	if err := json.Unmarshal(data, res); err != nil {
+		return nil, fmt.Errorf("invalid unsigned transaction: %v", err)
+	}
+	return res, nil
+}
+
+// ChainIDInt returns the chain ID of the unsigned transaction.
+func (u *UnsignedTransaction) ChainIDInt() (*big.Int, error) {
+	return parseWei("chain ID", u.ChainID)
+}
+
+// Transaction returns the transaction described by the unsigned transaction.
+func (u *UnsignedTransaction) Transaction() (*types.Transaction, error) {
+	chainID, err := u.ChainIDInt()
+	if err != nil {
+		return nil, err
+	}
+	value, err := parseWei("value", u.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	switch u.Type {
+	case types.LegacyTxType, types.AccessListTxType:
+		gasPrice, err := parseWei("gas price", u.GasPrice)
+		if err != nil {
+			return nil, err
+		}
+		if u.Type == types.LegacyTxType {
+			if len(u.AccessList) > 0 {
+				return nil, errors.New("legacy transaction cannot have an access list")
+			}
+			return types.NewTx(&types.LegacyTx{
+				Nonce:    u.Nonce,
+				GasPrice: gasPrice,
+				Gas:      u.Gas,
+				To:       u.To,
+				Value:    value,
+				Data:     u.Data,
+			}), nil
+		}
+		return types.NewTx(&types.AccessListTx{
+			ChainID:    chainID,
+			Nonce:      u.Nonce,
+			GasPrice:   gasPrice,
+			Gas:        u.Gas,
+			To:         u.To,
+			Value:      value,
+			Data:       u.Data,
+			AccessList: u.AccessList,
+		}), nil
+	case types.DynamicFeeTxType:
+		maxFeePerGas, err := parseWei("max fee per gas", u.MaxFeePerGas)
+		if err != nil {
+			return nil, err
+		}
+		maxPriorityFeePerGas, err := parseWei("max priority fee per gas", u.MaxPriorityFeePerGas)
+		if err != nil {
+			return nil, err
+		}
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:    chainID,
+			Nonce:      u.Nonce,
+			GasTipCap:  maxPriorityFeePerGas,
+			GasFeeCap:  maxFeePerGas,
+			Gas:        u.Gas,
+			To:         u.To,
+			Value:      value,
+			Data:       u.Data,
+			AccessList: u.AccessList,
+		}), nil
+	default:
+		return nil, fmt.Errorf("unsupported transaction type %d", u.Type)
+	}
+}
+
+func parseWei(name string, input string) (*big.Int, error) {
+	if input == "" {
+		return nil, fmt.Errorf("missing %s", name)
+	}
+	res, ok := new(big.Int).SetString(input, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid %s %q", name, input)
+	}
+	return res, nil
+}
@@ -0,0 +1,80 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnsignedTransactionRoundTrip(t *testing.T) {
+	from := common.HexToAddress("0x2c7536E3605D9C16a7a3D7b1898e529396a65c23")
+	to := common.HexToAddress("0x5FfC014343cd971B7eb70732021E26C35B744cc4")
+	chainID := big.NewInt(5)
+	accessList := types.AccessList{{Address: to, StorageKeys: []common.Hash{common.HexToHash("0x01")}}}
+
+	tests := []*types.Transaction{
+		types.NewTx(&types.LegacyTx{Nonce: 1, GasPrice: big.NewInt(1000000000), Gas: 21000, To: &to, Value: big.NewInt(1)}),
+		types.NewTx(&types.AccessListTx{ChainID: chainID, Nonce: 2, GasPrice: big.NewInt(1000000000), Gas: 30000, To: &to, Value: big.NewInt(0), Data: []byte{0x01, 0x02}, AccessList: accessList}),
+		types.NewTx(&types.DynamicFeeTx{ChainID: chainID, Nonce: 3, GasTipCap: big.NewInt(1000000000), GasFeeCap: big.NewInt(3000000000), Gas: 100000, Value: big.NewInt(0), Data: []byte{0x60, 0x80}}),
+	}
+
+	for i, tx := range tests {
+		data, err := json.Marshal(NewUnsignedTransaction(from, chainID, tx, ""))
+		require.Nil(t, err, fmt.Sprintf("failed to marshal at test %d", i))
+		unsigned, err := ParseUnsignedTransaction(string(data))
+		require.Nil(t, err, fmt.Sprintf("failed to parse at test %d", i))
+		assert.Equal(t, from, unsigned.From, fmt.Sprintf("incorrect from at test %d", i))
+		output, err := unsigned.Transaction()
+		require.Nil(t, err, fmt.Sprintf("failed to obtain transaction at test %d", i))
+		assert.Equal(t, tx.Hash(), output.Hash(), fmt.Sprintf("incorrect transaction at test %d", i))
+	}
+}
+
+func TestUnsignedTransactionInvalid(t *testing.T) {
+	tests := []struct {
+		input string
+	}{
+		{ // 0 - bad JSON
+			input: `{"type":`,
+		},
+		{ // 1 - missing chain ID
+			input: `{"type":0,"nonce":0,"gas":21000,"gasPrice":"1","value":"0"}`,
+		},
+		{ // 2 - missing max fee
+			input: `{"type":2,"chainId":"1","nonce":0,"gas":21000,"maxPriorityFeePerGas":"1","value":"0"}`,
+		},
+		{ // 3 - invalid value
+			input: `{"type":0,"chainId":"1","nonce":0,"gas":21000,"gasPrice":"1","value":"1 ether"}`,
+		},
+		{ // 4 - unknown type
+			input: `{"type":5,"chainId":"1","nonce":0,"gas":21000,"value":"0"}`,
+		},
+	}
+
+	for i, tt := range tests {
+		unsigned, err := ParseUnsignedTransaction(tt.input)
+		if err == nil {
+			_, err = unsigned.Transaction()
+		}
+		assert.NotNil(t, err, fmt.Sprintf("missing expected error at test %d", i))
+	}
+}
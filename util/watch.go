@@ -0,0 +1,201 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"os/exec"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// HeadReader is the interface required to watch for new blocks.
+type HeadReader interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error)
+}
+
+// watchRetryBackoff is the initial delay before asking the node for the
+// latest block again after a failure; it doubles with each failure.
+var watchRetryBackoff = time.Second
+
+// watchMaxRetryBackoff is the maximum delay between attempts to obtain the
+// latest block.
+var watchMaxRetryBackoff = time.Minute
+
+// WatchBlocks calls the handler with each range of blocks, starting at start,
+// as they obtain the given number of confirmations.  It returns when the
+// context is cancelled or the handler returns an error.
+//
+// If the connection supports subscriptions then new blocks are received as
+// they arrive, otherwise the node is polled at the given interval.  If timeout
+// is non-zero it applies to each request for the latest block.  Failed
+// requests are retried after an increasing delay, so the watch survives the
+// node being temporarily unavailable.
+func WatchBlocks(ctx context.Context,
+	reader HeadReader,
+	start uint64,
+	confirmations uint64,
+	pollInterval time.Duration,
+	timeout time.Duration,
+	handler func(from uint64, to uint64) error,
+) error {
+	if confirmations == 0 {
+		confirmations = 1
+	}
+
+	var ticker *time.Ticker
+	defer func() {
+		if ticker != nil {
+			ticker.Stop()
+		}
+	}()
+	var tick <-chan time.Time
+	heads := make(chan *types.Header, 16)
+	var headsErr <-chan error
+	sub, err := reader.SubscribeNewHead(ctx, heads)
+	if err == nil {
+		defer sub.Unsubscribe()
+		headsErr = sub.Err()
+	} else {
+		// Connection does not support subscriptions
+		ticker = time.NewTicker(pollInterval)
+		tick = ticker.C
+	}
+
+	next := start
+	backoff := watchRetryBackoff
+	for {
+		head, err := latestHeader(ctx, reader, timeout)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if err := sleep(ctx, backoff); err != nil {
+				return err
+			}
+			backoff *= 2
+			if backoff > watchMaxRetryBackoff {
+				backoff = watchMaxRetryBackoff
+			}
+			continue
+		}
+		backoff = watchRetryBackoff
+		if head.Number.Uint64()+1 >= next+confirmations {
+			to := head.Number.Uint64() + 1 - confirmations
+			if err := handler(next, to); err != nil {
+				return err
+			}
+			next = to + 1
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-heads:
+		case <-tick:
+		case <-headsErr:
+			// Subscription failed; fall back to polling
+			headsErr = nil
+			ticker = time.NewTicker(pollInterval)
+			tick = ticker.C
+		}
+	}
+}
+
+// latestHeader obtains the header of the latest block.
+func latestHeader(ctx context.Context, reader HeadReader, timeout time.Duration) (*types.Header, error) {
+	ctx, cancel := RequestContext(ctx, timeout)
+	defer cancel()
+	return reader.HeaderByNumber(ctx, nil)
+}
+
+// RequestContext returns a context for a single request to the node, which
+// times out after the given duration if it is non-zero.
+func RequestContext(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout > 0 {
+		return context.WithTimeout(ctx, timeout)
+	}
+	return context.WithCancel(ctx)
+}
+
+// Checkpoint records progress through the chain, allowing a watcher to resume
+// after a restart without missing or repeating events.
+type Checkpoint struct {
+	// Block is the next block to process.
+	Block uint64 `json:"block"`
+	// LogIndex is the index of the next log to process in the block.
+	LogIndex uint `json:"logIndex"`
+}
+
+// Processed returns true if the log at the given block and index has been
+// processed according to the checkpoint.
+func (c *Checkpoint) Processed(block uint64, logIndex uint) bool {
+	return block < c.Block || (block == c.Block && logIndex < c.LogIndex)
+}
+
+// LoadCheckpoint loads a checkpoint from the given file.  It returns nil if the
+// file does not exist.
+func LoadCheckpoint(path string) (*Checkpoint, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	checkpoint := &Checkpoint{}
+	if err := json.Unmarshal(data, checkpoint); err != nil {
+		return nil, fmt.Errorf("invalid checkpoint %s: %v", path, err)
+	}
+	return checkpoint, nil
+}
+
+// SaveCheckpoint saves a checkpoint to the given file.  The file is replaced
+// atomically so that an interrupted save does not corrupt it.
+func SaveCheckpoint(path string, checkpoint *Checkpoint) error {
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+	tmpPath := path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// RunHook runs a command with the shell, adding the supplied variables to its
+// environment and supplying input on its standard input.  The output of the
+// command is written to stdout and stderr.
+func RunHook(ctx context.Context, command string, env []string, input []byte, stdout io.Writer, stderr io.Writer) error {
+	cmd := exec.CommandContext(ctx, "/bin/sh", "-c", command)
+	cmd.Env = append(os.Environ(), env...)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("command failed: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,242 @@
+// Copyright © 2021 Weald Technology Trading
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubHeadReader returns successive heads each time it is asked for the latest
+// block, remaining at the final head once all have been returned.  The first
+// failures requests fail, either immediately or, if hang is set, when their
+// context expires.
+type stubHeadReader struct {
+	heads     []uint64
+	calls     int
+	failures  int
+	hang      bool
+	subscribe bool
+}
+
+func (s *stubHeadReader) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	if s.failures > 0 {
+		s.failures--
+		if s.hang {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+		return nil, errors.New("connection refused")
+	}
+	head := s.heads[len(s.heads)-1]
+	if s.calls < len(s.heads) {
+		head = s.heads[s.calls]
+	}
+	s.calls++
+	return &types.Header{Number: new(big.Int).SetUint64(head)}, nil
+}
+
+func (s *stubHeadReader) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	if !s.subscribe {
+		return nil, errors.New("notifications not supported")
+	}
+	// Send a head for each head that will be returned.
+	go func() {
+		for range s.heads {
+			select {
+			case ch <- &types.Header{}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		<-quit
+		return nil
+	}), nil
+}
+
+func TestWatchBlocks(t *testing.T) {
+	backoff := watchRetryBackoff
+	watchRetryBackoff = time.Millisecond
+	defer func() { watchRetryBackoff = backoff }()
+
+	tests := []struct {
+		heads         []uint64
+		start         uint64
+		confirmations uint64
+		subscribe     bool
+		failures      int
+		hang          bool
+		ranges        [][2]uint64
+	}{
+		{ // 0 - polling
+			heads: []uint64{10, 10, 12, 13}, start: 10, confirmations: 1,
+			ranges: [][2]uint64{{10, 10}, {11, 12}, {13, 13}},
+		},
+		{ // 1 - subscription
+			heads: []uint64{10, 10, 12, 13}, start: 10, confirmations: 1, subscribe: true,
+			ranges: [][2]uint64{{10, 10}, {11, 12}, {13, 13}},
+		},
+		{ // 2 - confirmations
+			heads: []uint64{10, 11, 12, 15}, start: 10, confirmations: 3,
+			ranges: [][2]uint64{{10, 10}, {11, 13}},
+		},
+		{ // 3 - start in the past
+			heads: []uint64{20}, start: 5, confirmations: 1,
+			ranges: [][2]uint64{{5, 20}},
+		},
+		{ // 4 - zero confirmations treated as one
+			heads: []uint64{20}, start: 20, confirmations: 0,
+			ranges: [][2]uint64{{20, 20}},
+		},
+		{ // 5 - node errors
+			heads: []uint64{10, 12}, start: 10, confirmations: 1, failures: 3,
+			ranges: [][2]uint64{{10, 10}, {11, 12}},
+		},
+		{ // 6 - node hangs
+			heads: []uint64{10, 12}, start: 10, confirmations: 1, failures: 2, hang: true,
+			ranges: [][2]uint64{{10, 10}, {11, 12}},
+		},
+	}
+
+	for i, test := range tests {
+		reader := &stubHeadReader{heads: test.heads, subscribe: test.subscribe, failures: test.failures, hang: test.hang}
+		ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+		ranges := make([][2]uint64, 0)
+		err := WatchBlocks(ctx, reader, test.start, test.confirmations, time.Millisecond, 10*time.Millisecond, func(from uint64, to uint64) error {
+			ranges = append(ranges, [2]uint64{from, to})
+			if len(ranges) == len(test.ranges) {
+				cancel()
+			}
+			return nil
+		})
+		cancel()
+		assert.Equal(t, context.Canceled, err, fmt.Sprintf("incorrect error at test %d", i))
+		assert.Equal(t, test.ranges, ranges, fmt.Sprintf("incorrect ranges at test %d", i))
+	}
+}
+
+func TestWatchBlocksHandlerError(t *testing.T) {
+	reader := &stubHeadReader{heads: []uint64{10}}
+	err := WatchBlocks(context.Background(), reader, 10, 1, time.Millisecond, 0, func(from uint64, to uint64) error {
+		return errors.New("handler failed")
+	})
+	assert.EqualError(t, err, "handler failed")
+}
+
+func TestRequestContext(t *testing.T) {
+	// A zero timeout does not expire.
+	ctx, cancel := RequestContext(context.Background(), 0)
+	_, hasDeadline := ctx.Deadline()
+	assert.False(t, hasDeadline)
+	assert.Nil(t, ctx.Err())
+	cancel()
+	assert.Equal(t, context.Canceled, ctx.Err())
+
+	ctx, cancel = RequestContext(context.Background(), time.Millisecond)
+	defer cancel()
+	<-ctx.Done()
+	assert.Equal(t, context.DeadlineExceeded, ctx.Err())
+}
+
+func TestCheckpoint(t *testing.T) {
+	dir, err := ioutil.TempDir("", "checkpoint")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "checkpoint.json")
+
+	checkpoint, err := LoadCheckpoint(path)
+	require.Nil(t, err)
+	assert.Nil(t, checkpoint)
+
+	require.Nil(t, SaveCheckpoint(path, &Checkpoint{Block: 12, LogIndex: 3}))
+	checkpoint, err = LoadCheckpoint(path)
+	require.Nil(t, err)
+	assert.Equal(t, &Checkpoint{Block: 12, LogIndex: 3}, checkpoint)
+	_, err = os.Stat(path + ".tmp")
+	assert.True(t, os.IsNotExist(err))
+
+	require.Nil(t, ioutil.WriteFile(path, []byte("bad"), 0600))
+	_, err = LoadCheckpoint(path)
+	assert.NotNil(t, err)
+}
+
+func TestCheckpointProcessed(t *testing.T) {
+	checkpoint := &Checkpoint{Block: 12, LogIndex: 3}
+	tests := []struct {
+		block     uint64
+		logIndex  uint
+		processed bool
+	}{
+		{block: 11, logIndex: 10, processed: true},
+		{block: 12, logIndex: 2, processed: true},
+		{block: 12, logIndex: 3, processed: false},
+		{block: 13, logIndex: 0, processed: false},
+	}
+
+	for i, test := range tests {
+		assert.Equal(t, test.processed, checkpoint.Processed(test.block, test.logIndex), fmt.Sprintf("incorrect result at test %d", i))
+	}
+}
+
+func TestRunHook(t *testing.T) {
+	tests := []struct {
+		command string
+		env     []string
+		input   string
+		output  string
+		err     bool
+	}{
+		{ // 0 - environment
+			command: `echo "$ETHEREAL_TEST"`,
+			env:     []string{"ETHEREAL_TEST=value"},
+			output:  "value\n",
+		},
+		{ // 1 - input
+			command: `cat`,
+			input:   `{"a":1}`,
+			output:  `{"a":1}`,
+		},
+		{ // 2 - failure
+			command: `exit 3`,
+			err:     true,
+		},
+	}
+
+	for i, test := range tests {
+		var stdout bytes.Buffer
+		err := RunHook(context.Background(), test.command, test.env, []byte(test.input), &stdout, ioutil.Discard)
+		if test.err {
+			assert.NotNil(t, err, fmt.Sprintf("missing error at test %d", i))
+		} else {
+			require.Nil(t, err, fmt.Sprintf("unexpected error at test %d", i))
+			assert.Equal(t, test.output, stdout.String(), fmt.Sprintf("incorrect output at test %d", i))
+		}
+	}
+}